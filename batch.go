@@ -0,0 +1,57 @@
+package kind
+
+import "reflect"
+
+// OfAll returns a slice of Kind instances that represent the types
+// of the given values, in the same order.
+//
+// Example usage:
+//
+//	kinds := kind.OfAll(42, "hello", []int{1, 2, 3})
+//	for _, k := range kinds {
+//		fmt.Println(k.Name()) // "int", "string", "[]int"
+//	}
+func OfAll(values ...interface{}) []*Kind {
+	result := make([]*Kind, len(values))
+	for i, v := range values {
+		result[i] = Of(v)
+	}
+
+	return result
+}
+
+// OfArgs returns a slice of Kind instances for the arguments of
+// a variadic call. It is intended for wrappers that forward their
+// arguments as is, for example, loggers and interceptors, so it is
+// optimized for hot paths: the Kind instances are allocated at once,
+// and consecutive arguments of the same type share one cache lookup.
+//
+// Example usage:
+//
+//	func logCall(name string, args ...interface{}) {
+//		for i, k := range kind.OfArgs(args...) {
+//			log.Printf("%s: arg %d is %s", name, i, k)
+//		}
+//	}
+func OfArgs(args ...interface{}) []*Kind {
+	kinds := make([]Kind, len(args))
+	result := make([]*Kind, len(args))
+
+	var last reflect.Type
+	node := nilNode
+	for i, v := range args {
+		switch t := reflect.TypeOf(v); {
+		case t == nil:
+			kinds[i] = Kind{typeNode: nilNode}
+		case t == last:
+			kinds[i] = Kind{typeNode: node, value: v}
+		default:
+			last, node = t, cachedNode(t)
+			kinds[i] = Kind{typeNode: node, value: v}
+		}
+
+		result[i] = &kinds[i]
+	}
+
+	return result
+}
@@ -0,0 +1,62 @@
+package kind
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestOfAll tests the kind.OfAll function.
+func TestOfAll(t *testing.T) {
+	values := []interface{}{42, "test", []int{1, 2, 3}, nil}
+	names := []string{"int", "string", "[]int", "nil"}
+
+	kinds := OfAll(values...)
+	if len(kinds) != len(values) {
+		t.Fatalf("Expected %d kinds, but got %d", len(values), len(kinds))
+	}
+
+	for i, k := range kinds {
+		if k.Name() != names[i] {
+			t.Errorf("Expected type name %s, but got %s", names[i], k.Name())
+		}
+	}
+
+	if kinds := OfAll(); len(kinds) != 0 {
+		t.Errorf("Expected empty result, but got %d kinds", len(kinds))
+	}
+}
+
+// TestOfArgs tests the kind.OfArgs function.
+func TestOfArgs(t *testing.T) {
+	wrapper := func(args ...interface{}) []*Kind {
+		return OfArgs(args...)
+	}
+
+	kinds := wrapper(int8(1), true)
+	if len(kinds) != 2 {
+		t.Fatalf("Expected 2 kinds, but got %d", len(kinds))
+	}
+
+	if !kinds[0].IsInt8() || !kinds[1].IsBool() {
+		t.Errorf("Expected int8 and bool, but got %s and %s",
+			kinds[0], kinds[1])
+	}
+
+	args := []interface{}{1, 2, nil, "a", map[string]int{}, 3}
+	for i, k := range OfArgs(args...) {
+		if ok, result := deepEqualKind(Of(args[i]), k); !ok {
+			t.Errorf("Argument %d: expected kind %v, but got %v:\n%s",
+				i, Of(args[i]), k, result)
+		}
+
+		if !reflect.DeepEqual(k.value, args[i]) {
+			t.Errorf("Argument %d: expected value %v, but got %v",
+				i, args[i], k.value)
+		}
+	}
+
+	allocs := testing.AllocsPerRun(100, func() { OfArgs(args...) })
+	if allocs > 2 {
+		t.Errorf("Expected at most 2 allocations, but got %v", allocs)
+	}
+}
@@ -0,0 +1,181 @@
+package kind
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidEncoding is returned when the binary encoding
+// of a Kind is malformed or has an unsupported version.
+var ErrInvalidEncoding = errors.New("kind: invalid binary encoding")
+
+// The binaryVersion is the version of the binary encoding of Kind.
+const binaryVersion = 1
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding holds
+// the version of the format and the Descriptor of the Kind: the name,
+// the type flags, the child Kinds, and the full name of the type, so
+// the type can be restored if it is known to Parse when decoding.
+// The stored value is not encoded.
+//
+// Example usage:
+//
+//	data, _ := kind.Of(map[string]int{}).MarshalBinary()
+//
+//	k := new(kind.Kind)
+//	k.UnmarshalBinary(data)
+//	fmt.Println(k.Name(), k.IsMap()) // "map[string]int" true
+func (k *Kind) MarshalBinary() ([]byte, error) {
+	return appendDescriptor([]byte{binaryVersion}, k.Descriptor()), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It must be
+// called on a new Kind instance, before the instance is shared. The
+// decoded Kind is built by FromDescriptor, but a type name that cannot
+// be built, such as an array too large for the address space, makes it
+// return ErrInvalidEncoding.
+func (k *Kind) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("%w: empty data", ErrInvalidEncoding)
+	}
+
+	if data[0] != binaryVersion {
+		return fmt.Errorf("%w: unsupported version %d",
+			ErrInvalidEncoding, data[0])
+	}
+
+	r := &binaryReader{data: data[1:]}
+	d := r.descriptor()
+	if r.err == nil && len(r.data) != 0 {
+		r.err = fmt.Errorf("%w: unexpected trailing data", ErrInvalidEncoding)
+	}
+
+	if r.err != nil {
+		return r.err
+	}
+
+	v, err := fromDescriptor(d)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
+	}
+
+	*k = *v
+	return nil
+}
+
+// appendDescriptor appends the encoding of the Descriptor to buf:
+// the name, the type name, the number of flags followed by the flag
+// bits, and the mask of the present children followed by the children.
+func appendDescriptor(buf []byte, d *Descriptor) []byte {
+	buf = appendString(buf, d.Name)
+	buf = appendString(buf, d.Type)
+
+	n := len(new(typeNode).flagPtrs())
+	bits := make([]byte, (n+7)/8)
+	for i := range bits {
+		bits[i] = byte(d.Flags >> (8 * i))
+	}
+
+	buf = binary.AppendUvarint(buf, uint64(n))
+	buf = append(buf, bits...)
+
+	var mask byte
+	if d.Key != nil {
+		mask |= 1
+	}
+
+	if d.Value != nil {
+		mask |= 2
+	}
+
+	buf = append(buf, mask)
+	if d.Key != nil {
+		buf = appendDescriptor(buf, d.Key)
+	}
+
+	if d.Value != nil {
+		buf = appendDescriptor(buf, d.Value)
+	}
+
+	return buf
+}
+
+// appendString appends the length-prefixed string to buf.
+func appendString(buf []byte, s string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+// The binaryReader reads the binary encoding of Kind.
+// The first error stops reading and is kept in err.
+type binaryReader struct {
+	data []byte
+	err  error
+}
+
+// descriptor reads the Descriptor encoded by appendDescriptor.
+func (r *binaryReader) descriptor() *Descriptor {
+	d := &Descriptor{Name: r.string(), Type: r.string()}
+
+	n := r.uvarint()
+	bits := r.bytes((n + 7) / 8)
+	for i := range bits {
+		// Flags unknown to this version are skipped.
+		if i < 8 {
+			d.Flags |= uint64(bits[i]) << (8 * i)
+		}
+	}
+
+	mask := r.bytes(1)
+	if len(mask) == 1 && mask[0]&1 != 0 {
+		d.Key = r.descriptor()
+	}
+
+	if len(mask) == 1 && mask[0]&2 != 0 {
+		d.Value = r.descriptor()
+	}
+
+	if r.err != nil {
+		return nil
+	}
+
+	return d
+}
+
+// uvarint reads the unsigned varint.
+func (r *binaryReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.err = fmt.Errorf("%w: invalid varint", ErrInvalidEncoding)
+		return 0
+	}
+
+	r.data = r.data[n:]
+	return v
+}
+
+// string reads the length-prefixed string.
+func (r *binaryReader) string() string {
+	return string(r.bytes(r.uvarint()))
+}
+
+// bytes reads n bytes.
+func (r *binaryReader) bytes(n uint64) []byte {
+	if r.err != nil {
+		return nil
+	}
+
+	if n > uint64(len(r.data)) {
+		r.err = fmt.Errorf("%w: unexpected end of data", ErrInvalidEncoding)
+		return nil
+	}
+
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b
+}
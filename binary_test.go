@@ -0,0 +1,128 @@
+package kind
+
+import (
+	"encoding"
+	"errors"
+	"testing"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = (*Kind)(nil)
+	_ encoding.BinaryUnmarshaler = (*Kind)(nil)
+)
+
+// TestMarshalBinary tests the Kind.MarshalBinary and
+// Kind.UnmarshalBinary methods.
+func TestMarshalBinary(t *testing.T) {
+	type unregistered struct{ A int }
+	type recursive map[string]recursive
+
+	tests := []struct {
+		name     string
+		kind     *Kind
+		resolved bool
+	}{
+		{"int", Of(1), true},
+		{"nil", Of(nil), false},
+		{"map", Of(map[string][]int{}), true},
+		{"channel", Of(make(chan *int)), true},
+		{"registered struct", Of([]Field{}), true},
+		{"unregistered struct", Of(map[string]unregistered{}), false},
+		{"recursive", Of(recursive{}), false},
+		{"undefined", &Kind{typeNode: &typeNode{name: "T", isUndefined: true}}, false},
+	}
+
+	Register(Field{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.kind.MarshalBinary()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			k := new(Kind)
+			if err := k.UnmarshalBinary(data); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if (k.rtype != nil) != tt.resolved {
+				t.Errorf("Expected resolved %v, but got %v",
+					tt.resolved, k.rtype != nil)
+			}
+
+			if tt.name == "recursive" {
+				// Deeply nested children are not encoded.
+				if k.Name() != tt.kind.Name() || !k.MapValueKind().IsMap() {
+					t.Errorf("Unexpected kind %s", k.Name())
+				}
+
+				return
+			}
+
+			if ok, diff := deepEqualKind(k, tt.kind); !ok {
+				t.Errorf("Decoded kind differs:\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestUnmarshalBinaryErrors tests the errors returned
+// by the Kind.UnmarshalBinary method.
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	data, _ := Of(map[string]int{}).MarshalBinary()
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"version", append([]byte{99}, data[1:]...)},
+		{"truncated", data[:len(data)-1]},
+		{"trailing", append(data[:len(data):len(data)], 0)},
+		{"varint", []byte{binaryVersion, 0xff}},
+		{
+			"array too large",
+			appendDescriptor([]byte{binaryVersion}, &Descriptor{
+				Name: "T",
+				Type: "[9223372036854775807][9223372036854775807]int64",
+			}),
+		},
+		{
+			"nested channel too large",
+			appendDescriptor([]byte{binaryVersion}, &Descriptor{
+				Name:  "map[string]T",
+				Type:  "map[string]T",
+				Value: &Descriptor{Name: "T", Type: "chan [65536]byte"},
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := Of(1)
+			err := k.UnmarshalBinary(tt.data)
+			if !errors.Is(err, ErrInvalidEncoding) {
+				t.Errorf("Expected ErrInvalidEncoding, but got %v", err)
+			}
+
+			if k.Name() != "int" {
+				t.Errorf("Expected the kind to be unchanged")
+			}
+		})
+	}
+}
+
+// TestUnmarshalBinaryFlags tests decoding of flags unknown to this version.
+func TestUnmarshalBinaryFlags(t *testing.T) {
+	// Name "x", no type, 40 flags with isNil and the 40th set, no children.
+	data := []byte{binaryVersion, 1, 'x', 0, 40, 2, 0, 0, 0, 0x80, 0}
+
+	k := new(Kind)
+	if err := k.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if k.Name() != "x" || !k.IsNil() || k.IsPointer() {
+		t.Errorf("Unexpected kind %s: nil %v", k.Name(), k.IsNil())
+	}
+}
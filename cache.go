@@ -0,0 +1,235 @@
+package kind
+
+import (
+	"container/list"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// The typeCache holds the type nodes for the types analyzed by Of and
+// for the child Kinds, so each type is analyzed only once. The nodes are
+// shared by the Kind instances of the type: Of creates a thin instance
+// that refers to the node and holds the value. The nodes and their child Kinds are built
+// once and never modified. If SetCacheLimit bounds the cache, the
+// nodes are held by the lru cache instead of the nodes map, and the
+// nodes of the types pinned by Warm are held by the pinned map.
+var typeCache struct {
+	nodes     sync.Map // reflect.Type -> *typeNode
+	pinned    sync.Map // reflect.Type -> *typeNode
+	lru       atomic.Pointer[lruCache]
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	size      atomic.Uint64
+	evictions atomic.Uint64
+}
+
+// CacheStatistics is a snapshot of the type cache statistics.
+type CacheStatistics struct {
+	Hits      uint64 `json:"hits"`      // lookups that found the type
+	Misses    uint64 `json:"misses"`    // lookups that analyzed the type
+	Size      uint64 `json:"size"`      // number of cached types
+	Evictions uint64 `json:"evictions"` // number of evicted types
+}
+
+// CacheStats returns the current statistics of the type cache.
+//
+// Example usage:
+//
+//	kind.Of(1)
+//	kind.Of(2)
+//	stats := kind.CacheStats()
+//	fmt.Println(stats.Hits >= 1, stats.Size >= 1) // true true
+func CacheStats() CacheStatistics {
+	return CacheStatistics{
+		Hits:      typeCache.hits.Load(),
+		Misses:    typeCache.misses.Load(),
+		Size:      typeCache.size.Load(),
+		Evictions: typeCache.evictions.Load(),
+	}
+}
+
+// SetCacheLimit bounds the type cache to n types: if a new type does not
+// fit, the least recently used type is evicted and analyzed again on its
+// next use. The Kind instances of evicted types stay valid. A value less
+// than or equal to zero restores the default unbounded cache. The cache
+// is emptied by every call, so it is meant to be called at startup, for
+// example, by plugins that analyze many short-lived types.
+//
+// Example usage:
+//
+//	kind.SetCacheLimit(1024)
+//	defer kind.SetCacheLimit(0)
+func SetCacheLimit(n int) {
+	var lru *lruCache
+	if n > 0 {
+		lru = newLRUCache(n)
+	}
+
+	typeCache.lru.Store(lru)
+	typeCache.nodes.Range(func(t, _ interface{}) bool {
+		typeCache.nodes.Delete(t)
+		return true
+	})
+
+	var size uint64
+	typeCache.pinned.Range(func(t, n interface{}) bool {
+		if lru == nil {
+			typeCache.nodes.Store(t, n)
+		}
+
+		size++
+		return true
+	})
+	typeCache.size.Store(size)
+}
+
+// Warm analyzes the types of the given values and pins them in the type
+// cache, so the first calls of Of for these types in serving paths do
+// not pay for the analysis, and SetCacheLimit never evicts them. A value
+// that is a reflect.Type pins the type itself, which is the way to warm
+// interface types; nil values are ignored.
+//
+// Example usage:
+//
+//	func init() {
+//		kind.Warm(User{}, &Order{}, map[string]Item{},
+//			reflect.TypeOf((*error)(nil)).Elem())
+//	}
+func Warm(values ...interface{}) {
+	for _, v := range values {
+		t, ok := v.(reflect.Type)
+		if !ok {
+			t = reflect.TypeOf(v)
+		}
+
+		if t != nil {
+			pin(t)
+		}
+	}
+}
+
+// pin adds the node of the type to the pinned types. In the bounded
+// cache mode the node is moved from the lru cache to the pinned map.
+func pin(t reflect.Type) {
+	n := cachedNode(t)
+	if _, loaded := typeCache.pinned.LoadOrStore(t, n); loaded {
+		return
+	}
+
+	if lru := typeCache.lru.Load(); lru != nil {
+		lru.remove(t)
+		typeCache.size.Add(1)
+	}
+}
+
+// cachedNode returns the shared type node for the type,
+// analyzing the type on the first call.
+func cachedNode(t reflect.Type) *typeNode {
+	lru := typeCache.lru.Load()
+	if lru != nil {
+		if n, ok := typeCache.pinned.Load(t); ok {
+			typeCache.hits.Add(1)
+			notify(func() Event { return Event{Type: EventCacheHit, RType: t} })
+			return n.(*typeNode)
+		}
+
+		if n, ok := lru.load(t); ok {
+			typeCache.hits.Add(1)
+			notify(func() Event { return Event{Type: EventCacheHit, RType: t} })
+			return n
+		}
+	} else if n, ok := typeCache.nodes.Load(t); ok {
+		typeCache.hits.Add(1)
+		notify(func() Event { return Event{Type: EventCacheHit, RType: t} })
+		return n.(*typeNode)
+	}
+
+	typeCache.misses.Add(1)
+	notify(func() Event { return Event{Type: EventCacheMiss, RType: t} })
+	if lru != nil {
+		return lru.loadOrStore(t, buildKind(t).typeNode)
+	}
+
+	n, loaded := typeCache.nodes.LoadOrStore(t, buildKind(t).typeNode)
+	if !loaded {
+		typeCache.size.Add(1)
+	}
+
+	return n.(*typeNode)
+}
+
+// The lruCache is the bounded type cache set by SetCacheLimit.
+type lruCache struct {
+	mu      sync.Mutex
+	limit   int
+	order   *list.List                     // *lruEntry, most recent first
+	entries map[reflect.Type]*list.Element // elements of order
+}
+
+// The lruEntry is a cached type node.
+type lruEntry struct {
+	t    reflect.Type
+	node *typeNode
+}
+
+// newLRUCache returns the empty cache of the given size.
+func newLRUCache(limit int) *lruCache {
+	return &lruCache{
+		limit:   limit,
+		order:   list.New(),
+		entries: make(map[reflect.Type]*list.Element, limit),
+	}
+}
+
+// load returns the cached node for the type and marks it as used.
+func (c *lruCache) load(t reflect.Type) (*typeNode, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[t]
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(e)
+	return e.Value.(*lruEntry).node, true
+}
+
+// remove removes the type from the cache.
+func (c *lruCache) remove(t reflect.Type) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[t]; ok {
+		c.order.Remove(e)
+		delete(c.entries, t)
+		typeCache.size.Add(^uint64(0))
+	}
+}
+
+// loadOrStore returns the cached node for the type if there is one, or
+// caches the given node, evicting the least recently used one if the
+// cache is full.
+func (c *lruCache) loadOrStore(t reflect.Type, n *typeNode) *typeNode {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[t]; ok {
+		c.order.MoveToFront(e)
+		return e.Value.(*lruEntry).node
+	}
+
+	if c.order.Len() >= c.limit {
+		last := c.order.Back()
+		c.order.Remove(last)
+		delete(c.entries, last.Value.(*lruEntry).t)
+		typeCache.evictions.Add(1)
+		typeCache.size.Add(^uint64(0))
+	}
+
+	c.entries[t] = c.order.PushFront(&lruEntry{t: t, node: n})
+	typeCache.size.Add(1)
+
+	return n
+}
@@ -0,0 +1,153 @@
+package kind
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// TestCacheStats tests the kind.CacheStats function.
+func TestCacheStats(t *testing.T) {
+	type cached struct{ A int }
+
+	before := CacheStats()
+	Of(cached{A: 1})
+	Of(cached{A: 2})
+	Of(&cached{})
+	after := CacheStats()
+
+	if after.Misses-before.Misses != 2 {
+		t.Errorf("Expected 2 misses, but got %d", after.Misses-before.Misses)
+	}
+
+	if after.Hits-before.Hits != 1 {
+		t.Errorf("Expected 1 hit, but got %d", after.Hits-before.Hits)
+	}
+
+	if after.Size-before.Size != 2 {
+		t.Errorf("Expected 2 new types, but got %d", after.Size-before.Size)
+	}
+}
+
+// TestCacheValues tests that the cached Kinds carry their own values.
+func TestCacheValues(t *testing.T) {
+	a, b := Of(1), Of(2)
+	if x, _ := a.AsInt(); x != 1 {
+		t.Errorf("Expected 1, but got %d", x)
+	}
+
+	if x, _ := b.AsInt(); x != 2 {
+		t.Errorf("Expected 2, but got %d", x)
+	}
+
+	if a.typeNode != b.typeNode {
+		t.Error("Expected the type node to be shared")
+	}
+
+	m1, m2 := Of(map[int]string{}), Of(map[int]string{1: "a"})
+	if m1.MapKeyKind() != m2.MapKeyKind() {
+		t.Error("Expected child kinds to be shared")
+	}
+}
+
+// TestCacheConcurrent tests concurrent use of the type cache.
+func TestCacheConcurrent(t *testing.T) {
+	type concurrent struct{ M map[string][]int }
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			k := Of(map[string]concurrent{"x": {}})
+			if !k.IsMap() || !k.MapValueKind().IsStruct() {
+				t.Errorf("Unexpected kind %+v", k)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+// TestSetCacheLimit tests the bounded type cache set by kind.SetCacheLimit.
+func TestSetCacheLimit(t *testing.T) {
+	type a struct{ A int }
+	type b struct{ B int }
+	type c struct{ C int }
+
+	SetCacheLimit(2)
+	defer SetCacheLimit(0)
+
+	// Only the pinned types are left.
+	before := CacheStats()
+
+	Of(a{}) // miss: a
+	Of(b{}) // miss: b, a
+	Of(a{}) // hit: a, b
+	Of(c{}) // miss, b is evicted: c, a
+	Of(a{}) // hit: a, c
+	Of(b{}) // miss, c is evicted: b, a
+	after := CacheStats()
+
+	tests := []struct {
+		name     string
+		got      uint64
+		expected uint64
+	}{
+		{"misses", after.Misses - before.Misses, 4},
+		{"hits", after.Hits - before.Hits, 2},
+		{"evictions", after.Evictions - before.Evictions, 2},
+		{"size", after.Size - before.Size, 2},
+	}
+
+	for _, test := range tests {
+		if test.got != test.expected {
+			t.Errorf("Expected %d %s, but got %d",
+				test.expected, test.name, test.got)
+		}
+	}
+
+	if k := Of(c{C: 1}); !k.IsStruct() || k.Name() != Of(c{}).Name() {
+		t.Errorf("Unexpected kind %+v of an evicted type", k)
+	}
+
+	SetCacheLimit(0)
+	Of(a{})
+	if size := CacheStats().Size - before.Size; size != 1 {
+		t.Errorf("Expected 1 type in the unbounded cache, but got %d", size)
+	}
+}
+
+// TestWarm tests the kind.Warm function.
+func TestWarm(t *testing.T) {
+	type hot struct{ H int }
+	type cold1 struct{ C int }
+	type cold2 struct{ C int }
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+
+	SetCacheLimit(1)
+	defer SetCacheLimit(0)
+
+	Warm(hot{}, errorType, nil)
+	if size := CacheStats().Size; size < 2 {
+		t.Fatalf("Expected at least 2 pinned types, but got %d", size)
+	}
+
+	before := CacheStats()
+	Of(cold1{})
+	Of(cold2{}) // evicts cold1, but not the pinned types
+	Of(hot{})
+	after := CacheStats()
+
+	if hits := after.Hits - before.Hits; hits != 1 {
+		t.Errorf("Expected 1 hit for the pinned type, but got %d", hits)
+	}
+
+	if evictions := after.Evictions - before.Evictions; evictions != 1 {
+		t.Errorf("Expected 1 eviction, but got %d", evictions)
+	}
+
+	if k := Of(hot{}); !k.IsStruct() || k.Name() != "kind.hot" {
+		t.Errorf("Unexpected kind %+v of a pinned type", k)
+	}
+}
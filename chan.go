@@ -0,0 +1,103 @@
+package kind
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	// ErrNotChannel is returned by Send and Recv when the Kind instance
+	// does not hold a non-nil channel value.
+	ErrNotChannel = errors.New("kind: not a channel value")
+
+	// ErrChanDir is returned by Send and Recv when the direction of the
+	// channel does not allow the operation.
+	ErrChanDir = errors.New("kind: invalid channel direction")
+
+	// ErrClosedChannel is returned by Send when the channel is closed.
+	ErrClosedChannel = errors.New("kind: send on closed channel")
+)
+
+// Send sends the value on the channel held by the Kind instance,
+// blocking until the value is received. It checks first that the value
+// can be sent: the channel must allow sending, otherwise Send returns
+// ErrChanDir, and the value must be assignable to the element type,
+// otherwise it returns ErrKindMismatch. A nil value is sent as the zero
+// value of the types that can be nil. Sending on a closed channel
+// returns ErrClosedChannel instead of panicking.
+//
+// Example usage:
+//
+//	ch := make(chan int, 1)
+//	k := kind.Of(ch)
+//	err := k.Send(42)      // nil
+//	err = k.Send("answer") // ErrKindMismatch
+func (k *Kind) Send(v interface{}) (err error) {
+	ch, err := k.chanValue(reflect.SendDir)
+	if err != nil {
+		return err
+	}
+
+	elem := ch.Type().Elem()
+	var rv reflect.Value
+	switch {
+	case v == nil && nullable(elem):
+		rv = reflect.Zero(elem)
+	case v == nil:
+		return mismatch("", elem, nil)
+	case !reflect.TypeOf(v).AssignableTo(elem):
+		return mismatch("", elem, reflect.TypeOf(v))
+	default:
+		rv = reflect.ValueOf(v)
+	}
+
+	defer func() {
+		if recover() != nil {
+			err = ErrClosedChannel
+		}
+	}()
+
+	ch.Send(rv)
+	return nil
+}
+
+// Recv receives a value from the channel held by the Kind instance,
+// blocking until a value is sent or the channel is closed. The boolean
+// is false if the value is the zero value of a closed channel. Recv
+// returns ErrChanDir if the channel does not allow receiving.
+//
+// Example usage:
+//
+//	ch := make(chan string, 1)
+//	ch <- "hello"
+//	v, ok, err := kind.Of(ch).Recv()
+//	fmt.Println(v, ok, err) // hello true <nil>
+func (k *Kind) Recv() (interface{}, bool, error) {
+	ch, err := k.chanValue(reflect.RecvDir)
+	if err != nil {
+		return nil, false, err
+	}
+
+	v, ok := ch.Recv()
+	return v.Interface(), ok, nil
+}
+
+// chanValue returns the channel held by the Kind instance
+// if its direction allows the operation.
+func (k *Kind) chanValue(dir reflect.ChanDir) (reflect.Value, error) {
+	if k.rtype == nil || k.rtype.Kind() != reflect.Chan || k.value == nil {
+		return reflect.Value{}, ErrNotChannel
+	}
+
+	ch := reflect.ValueOf(k.value)
+	if ch.IsNil() {
+		return reflect.Value{}, ErrNotChannel
+	}
+
+	if ch.Type().ChanDir()&dir == 0 {
+		return reflect.Value{}, fmt.Errorf("%w: %s", ErrChanDir, k.rtype)
+	}
+
+	return ch, nil
+}
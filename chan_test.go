@@ -0,0 +1,75 @@
+package kind
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestSend tests the Send method.
+func TestSend(t *testing.T) {
+	closed := make(chan int, 1)
+	close(closed)
+
+	tests := []struct {
+		name  string
+		ch    interface{}
+		value interface{}
+		err   error
+	}{
+		{"int", make(chan int, 1), 42, nil},
+		{"interface", make(chan fmt.Stringer, 1), Of(1).rtype, nil},
+		{"not implemented", make(chan fmt.Stringer, 1), 1, ErrKindMismatch},
+		{"nil pointer", make(chan *int, 1), nil, nil},
+		{"nil int", make(chan int, 1), nil, ErrKindMismatch},
+		{"mismatch", make(chan int, 1), "42", ErrKindMismatch},
+		{"send only", make(chan<- int, 1), 42, nil},
+		{"receive only", make(<-chan int), 42, ErrChanDir},
+		{"closed", closed, 42, ErrClosedChannel},
+		{"nil channel", (chan int)(nil), 42, ErrNotChannel},
+		{"not channel", 42, 42, ErrNotChannel},
+		{"nil", nil, 42, ErrNotChannel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Of(tt.ch).Send(tt.value); !errors.Is(err, tt.err) {
+				t.Errorf("Expected error %v, but got %v", tt.err, err)
+			}
+		})
+	}
+
+	if err := For[chan int]().Send(1); !errors.Is(err, ErrNotChannel) {
+		t.Errorf("Expected ErrNotChannel without a value, but got %v", err)
+	}
+}
+
+// TestRecv tests the Recv method.
+func TestRecv(t *testing.T) {
+	ch := make(chan string, 2)
+	ch <- "hello"
+	close(ch)
+
+	k := Of(ch)
+	if v, ok, err := k.Recv(); v != "hello" || !ok || err != nil {
+		t.Errorf("Expected hello true <nil>, but got %v %v %v", v, ok, err)
+	}
+
+	if v, ok, err := k.Recv(); v != "" || ok || err != nil {
+		t.Errorf("Expected closed channel, but got %q %v %v", v, ok, err)
+	}
+
+	var recvOnly <-chan string = ch
+	if _, _, err := Of(recvOnly).Recv(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	var sendOnly chan<- string = make(chan string)
+	if _, _, err := Of(sendOnly).Recv(); !errors.Is(err, ErrChanDir) {
+		t.Errorf("Expected ErrChanDir, but got %v", err)
+	}
+
+	if _, _, err := Of("ch").Recv(); !errors.Is(err, ErrNotChannel) {
+		t.Errorf("Expected ErrNotChannel, but got %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package kind
+
+import "reflect"
+
+// Clone returns a copy of the Kind instance with the same type
+// information and value. The copy is independent of the original, so
+// it is not returned to the pool by Release, but it shares the type
+// information, which is never modified once built.
+func (k *Kind) Clone() *Kind {
+	return &Kind{typeNode: k.typeNode, value: k.value}
+}
+
+// WithValue returns a copy of the Kind instance with the value attached
+// instead of the stored one. The value must be of the represented type,
+// or implement it if the Kind instance represents an interface type,
+// otherwise WithValue returns the ErrKindMismatch error. A nil value is
+// accepted for the types that can be nil and stored as their zero value.
+//
+// Example usage:
+//
+//	k := kind.For[User]()
+//	uk, err := k.WithValue(User{Name: "Alice"})
+//	if err != nil {
+//		return err
+//	}
+//
+//	_, err = k.WithValue("Alice") // ErrKindMismatch
+func (k *Kind) WithValue(v interface{}) (*Kind, error) {
+	c := k.Clone()
+	if v == nil {
+		if k.rtype != nil && !nullable(k.rtype) {
+			return nil, mismatch("", k.rtype, nil)
+		}
+
+		c.value = nil
+		if k.rtype != nil && k.rtype.Kind() != reflect.Interface {
+			c.value = reflect.Zero(k.rtype).Interface()
+		}
+
+		return c, nil
+	}
+
+	t := reflect.TypeOf(v)
+	switch {
+	case k.rtype == nil:
+		return nil, mismatch("", nil, t)
+	case k.rtype.Kind() == reflect.Interface:
+		if !t.Implements(k.rtype) {
+			return nil, mismatch("", k.rtype, t)
+		}
+	case t != k.rtype:
+		return nil, mismatch("", k.rtype, t)
+	}
+
+	c.value = v
+	return c, nil
+}
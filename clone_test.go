@@ -0,0 +1,85 @@
+package kind
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// TestClone tests the Clone method.
+func TestClone(t *testing.T) {
+	type Tree struct {
+		Children []Tree
+	}
+
+	k := DeepOf(Tree{})
+	c := k.Clone()
+	if c == k || !c.Equal(k) || !reflect.DeepEqual(c.value, k.value) {
+		t.Fatalf("Expected an equal copy, but got %+v", c)
+	}
+
+	if c.typeNode != k.typeNode {
+		t.Error("Expected the type information to be shared")
+	}
+
+	p := AcquireOf(1)
+	c = p.Clone()
+	p.Release()
+	if n, ok := c.AsInt(); !ok || n != 1 {
+		t.Errorf("Expected the copy to survive Release, but got %v", c)
+	}
+}
+
+// TestWithValue tests the WithValue method.
+func TestWithValue(t *testing.T) {
+	type User struct{ Name string }
+
+	tests := []struct {
+		name  string
+		kind  *Kind
+		value interface{}
+		err   bool
+	}{
+		{"same type", For[User](), User{Name: "Alice"}, false},
+		{"other type", For[User](), "Alice", true},
+		{"pointer", For[User](), &User{}, true},
+		{"replace value", Of(1), 2, false},
+		{"interface", For[fmt.Stringer](), reflect.TypeOf(1), false},
+		{"not implemented", For[fmt.Stringer](), 1, true},
+		{"nil pointer", For[*User](), nil, false},
+		{"nil struct", For[User](), nil, true},
+		{"nil kind", Of(nil), nil, false},
+		{"nil kind value", Of(nil), 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := tt.kind.WithValue(tt.value)
+			if tt.err {
+				if !errors.Is(err, ErrKindMismatch) {
+					t.Errorf("Expected ErrKindMismatch, but got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !c.Equal(tt.kind) || c == tt.kind {
+				t.Errorf("Expected a copy of %v, but got %v", tt.kind, c)
+			}
+
+			if tt.value != nil && c.value != tt.value {
+				t.Errorf("Expected value %v, but got %v", tt.value, c.value)
+			}
+		})
+	}
+
+	c, _ := For[*User]().WithValue(nil)
+	if p, ok := c.value.(*User); !ok || p != nil {
+		t.Errorf("Expected typed nil, but got %#v", c.value)
+	}
+}
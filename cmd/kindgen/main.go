@@ -0,0 +1,195 @@
+// Kindgen generates strongly-typed kind helpers for user types.
+//
+// For each type T named by the -type flag, kindgen writes:
+//
+//	IsT(v interface{}) bool          // v holds a T
+//	AsT(v interface{}) (T, bool)     // v as a T
+//	KindOfT() *kind.Kind             // precomputed Kind of T
+//	OfT(v T) *kind.Kind              // pooled Kind of T holding v
+//
+// The Kind of T is computed once at package initialization by kind.For,
+// so it also represents interface types, and the KindOfT function does
+// not allocate. The OfT function reuses it with the value, taking the
+// instance from the pool, see kind.AcquireWith. For unexported types,
+// the helpers are unexported too (isT, asT, kindOfT, ofT).
+//
+// Usage:
+//
+//	kindgen -type=Config,User [-output=file] [directory]
+//
+// Typically it is invoked by a go:generate directive:
+//
+//	//go:generate kindgen -type=Config
+//
+// The output is written to <type>_kind.go in the package directory
+// by default, where <type> is the lower-cased name of the first type.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	typeNames = flag.String("type", "", "comma-separated list of type names; must be set")
+	output    = flag.String("output", "", "output file name; default <type>_kind.go")
+)
+
+// usage prints the usage message of the command.
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage of kindgen:\n")
+	fmt.Fprintf(os.Stderr, "\tkindgen -type=T[,T...] [-output=file] [directory]\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("kindgen: ")
+	flag.Usage = usage
+	flag.Parse()
+
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	types := strings.Split(*typeNames, ",")
+	pkg, err := parsePackage(dir, types)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := generate(pkg, types, strings.Join(os.Args[1:], " "))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	name := *output
+	if name == "" {
+		name = filepath.Join(dir, strings.ToLower(types[0])+"_kind.go")
+	}
+
+	if err := os.WriteFile(name, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parsePackage returns the name of the package in the directory and
+// checks that all types are declared in it as non-generic types.
+func parsePackage(dir string, types []string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+
+	pkg := ""
+	declared := make(map[string]bool)
+	fset := token.NewFileSet()
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") ||
+			strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			return "", err
+		}
+
+		pkg = f.Name.Name
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				declared[ts.Name.Name] = ts.TypeParams == nil
+			}
+		}
+	}
+
+	if pkg == "" {
+		return "", fmt.Errorf("no Go files in %s", dir)
+	}
+
+	for _, t := range types {
+		nonGeneric, ok := declared[t]
+		if !ok {
+			return "", fmt.Errorf("type %s is not declared in %s", t, dir)
+		} else if !nonGeneric {
+			return "", fmt.Errorf("type %s is generic", t)
+		}
+	}
+
+	return pkg, nil
+}
+
+// generate returns the formatted source of the helpers for the types.
+func generate(pkg string, types []string, args string) ([]byte, error) {
+	if len(types) == 0 {
+		return nil, errors.New("no types")
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by \"kindgen %s\"; DO NOT EDIT.\n\n", args)
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&buf, "import \"github.com/goloop/kind\"\n")
+
+	for _, t := range types {
+		is, as, kindOf, of := "Is", "As", "KindOf", "Of"
+		if r, _ := utf8.DecodeRuneInString(t); !unicode.IsUpper(r) {
+			is, as, kindOf, of = "is", "as", "kindOf", "of"
+		}
+
+		suffix := upperFirst(t)
+		v := "kindgen" + suffix
+		fmt.Fprintf(&buf, "\n// %s is the precomputed Kind of the %s type.\n", v, t)
+		fmt.Fprintf(&buf, "var %s = kind.For[%s]()\n", v, t)
+
+		fmt.Fprintf(&buf, "\n// %s%s returns true if v holds a value of the %s type.\n", is, suffix, t)
+		fmt.Fprintf(&buf, "func %s%s(v interface{}) bool {\n", is, suffix)
+		fmt.Fprintf(&buf, "\t_, ok := v.(%s)\n\treturn ok\n}\n", t)
+
+		fmt.Fprintf(&buf, "\n// %s%s returns v as a value of the %s type.\n", as, suffix, t)
+		fmt.Fprintf(&buf, "func %s%s(v interface{}) (%s, bool) {\n", as, suffix, t)
+		fmt.Fprintf(&buf, "\tr, ok := v.(%s)\n\treturn r, ok\n}\n", t)
+
+		fmt.Fprintf(&buf, "\n// %s%s returns the Kind of the %s type.\n", kindOf, suffix, t)
+		fmt.Fprintf(&buf, "// The instance is shared and must not be modified.\n")
+		fmt.Fprintf(&buf, "func %s%s() *kind.Kind {\n", kindOf, suffix)
+		fmt.Fprintf(&buf, "\treturn %s\n}\n", v)
+
+		fmt.Fprintf(&buf, "\n// %s%s returns the Kind of the %s type holding v.\n", of, suffix, t)
+		fmt.Fprintf(&buf, "// The instance is taken from the pool and can be returned by Release.\n")
+		fmt.Fprintf(&buf, "func %s%s(v %s) *kind.Kind {\n", of, suffix, t)
+		fmt.Fprintf(&buf, "\treturn %s.AcquireWith(v)\n}\n", v)
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// upperFirst returns the string with the first letter in upper case.
+func upperFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
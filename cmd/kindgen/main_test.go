@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// TestGenerate tests the generate function.
+func TestGenerate(t *testing.T) {
+	src, err := generate("app", []string{"Config", "user"}, "-type=Config,user")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []string{
+		`// Code generated by "kindgen -type=Config,user"; DO NOT EDIT.`,
+		"package app",
+		"var kindgenConfig = kind.For[Config]()",
+		"func IsConfig(v interface{}) bool {",
+		"func AsConfig(v interface{}) (Config, bool) {",
+		"func KindOfConfig() *kind.Kind {",
+		"func OfConfig(v Config) *kind.Kind {",
+		"var kindgenUser = kind.For[user]()",
+		"func isUser(v interface{}) bool {",
+		"func asUser(v interface{}) (user, bool) {",
+		"func kindOfUser() *kind.Kind {",
+		"func ofUser(v user) *kind.Kind {",
+	}
+
+	for _, e := range expected {
+		if !strings.Contains(string(src), e) {
+			t.Errorf("Expected output to contain %q:\n%s", e, src)
+		}
+	}
+}
+
+// TestGenerateGolden tests the generate function against the golden
+// file, which is rewritten by running the test with the -update flag.
+func TestGenerateGolden(t *testing.T) {
+	src, err := generate("app", []string{"Config", "handler"},
+		"-type=Config,handler")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	golden := filepath.Join("testdata", "app_kind.golden")
+	if *update {
+		if err := os.WriteFile(golden, src, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(src, expected) {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, src)
+	}
+}
+
+// TestParsePackage tests the parsePackage function.
+func TestParsePackage(t *testing.T) {
+	dir := t.TempDir()
+	src := "package app\n\ntype Config struct{}\n\ntype List[T any] []T\n"
+	err := os.WriteFile(filepath.Join(dir, "app.go"), []byte(src), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkg, err := parsePackage(dir, []string{"Config"})
+	if err != nil || pkg != "app" {
+		t.Errorf("Expected package app, but got %q (%v)", pkg, err)
+	}
+
+	if _, err := parsePackage(dir, []string{"Missing"}); err == nil {
+		t.Error("Expected error for missing type")
+	}
+
+	if _, err := parsePackage(dir, []string{"List"}); err == nil {
+		t.Error("Expected error for generic type")
+	}
+
+	if _, err := parsePackage(t.TempDir(), []string{"Config"}); err == nil {
+		t.Error("Expected error for empty directory")
+	}
+}
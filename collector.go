@@ -0,0 +1,341 @@
+package kind
+
+import (
+	"fmt"
+	"math/bits"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+)
+
+// KindSummary holds the statistics of the values of one kind
+// observed by a Collector.
+type KindSummary struct {
+	// Count is the number of observed values.
+	Count int
+
+	// Examples holds the first observed values, up to
+	// the number given to NewCollector.
+	Examples []interface{}
+
+	// Min and Max are the smallest and the largest observed numbers,
+	// converted to float64. They are set only for numeric kinds.
+	Min, Max float64
+
+	// Lengths is the distribution of the lengths of strings, slices,
+	// arrays, maps and channels in power-of-two buckets: Lengths[0]
+	// counts the values of length 0, and Lengths[i] counts the values
+	// with lengths in [2^(i-1), 2^i). It is nil for other kinds.
+	Lengths []int
+
+	// MinLen and MaxLen are the smallest and the largest observed
+	// lengths. They are set only if Lengths is not nil.
+	MinLen, MaxLen int
+}
+
+// Collector collects statistics of the values by their kind names.
+// It is safe for concurrent use. The zero Collector is ready to use
+// and keeps no example values.
+//
+// Example usage:
+//
+//	c := kind.NewCollector(2)
+//	for _, v := range []interface{}{1, 5, "a", "abc", []int{1, 2}} {
+//		c.Observe(v)
+//	}
+//
+//	s := c.Snapshot()
+//	fmt.Println(s["int"].Count, s["int"].Min, s["int"].Max) // 2 1 5
+//	fmt.Println(s["string"].Examples, s["string"].MaxLen)   // [a abc] 3
+type Collector struct {
+	mu       sync.Mutex
+	examples int
+	summary  map[string]*KindSummary
+}
+
+// NewCollector returns a new Collector that keeps up to
+// the given number of example values per kind.
+func NewCollector(examples int) *Collector {
+	return &Collector{examples: examples}
+}
+
+// Observe adds the value to the statistics.
+func (c *Collector) Observe(v interface{}) {
+	// The Kind is needed only for the name,
+	// so the pooled instance is enough.
+	k := AcquireOf(v)
+	name := k.name
+	k.Release()
+
+	rv := reflect.ValueOf(v)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.summary == nil {
+		c.summary = make(map[string]*KindSummary)
+	}
+
+	s, ok := c.summary[name]
+	if !ok {
+		s = &KindSummary{}
+		c.summary[name] = s
+	}
+
+	s.Count++
+	if len(s.Examples) < c.examples {
+		s.Examples = append(s.Examples, v)
+	}
+
+	if n, ok := numberOf(rv); ok {
+		if s.Count == 1 || n < s.Min {
+			s.Min = n
+		}
+
+		if s.Count == 1 || n > s.Max {
+			s.Max = n
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map,
+		reflect.Chan:
+		n := rv.Len()
+		if s.Lengths == nil || n < s.MinLen {
+			s.MinLen = n
+		}
+
+		if s.Lengths == nil || n > s.MaxLen {
+			s.MaxLen = n
+		}
+
+		bucket := bits.Len(uint(n))
+		for len(s.Lengths) <= bucket {
+			s.Lengths = append(s.Lengths, 0)
+		}
+
+		s.Lengths[bucket]++
+	}
+}
+
+// Snapshot returns a copy of the statistics collected
+// so far, keyed by kind name.
+func (c *Collector) Snapshot() map[string]KindSummary {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := make(map[string]KindSummary, len(c.summary))
+	for name, s := range c.summary {
+		summary := *s
+		summary.Examples = append([]interface{}(nil), s.Examples...)
+		if s.Lengths != nil {
+			summary.Lengths = append([]int(nil), s.Lengths...)
+		}
+
+		result[name] = summary
+	}
+
+	return result
+}
+
+// Examples returns up to perKind example values of each observed kind,
+// keyed by kind name, from the ones retained by the Collector, see
+// NewCollector. A value of perKind less than or equal to zero means all
+// retained values. The kinds without retained values are omitted.
+//
+// Example usage:
+//
+//	c := kind.NewCollector(5)
+//	for _, v := range []interface{}{1, "a", 2, 3} {
+//		c.Observe(v)
+//	}
+//
+//	fmt.Println(c.Examples(2)) // map[int:[1 2] string:[a]]
+func (c *Collector) Examples(perKind int) map[string][]interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := make(map[string][]interface{}, len(c.summary))
+	for name, s := range c.summary {
+		examples := s.Examples
+		if perKind > 0 && perKind < len(examples) {
+			examples = examples[:perKind]
+		}
+
+		if len(examples) > 0 {
+			result[name] = append([]interface{}(nil), examples...)
+		}
+	}
+
+	return result
+}
+
+// KindCount is the number of the observed values of one kind.
+type KindCount struct {
+	Name    string  `json:"name"`    // kind name
+	Count   int     `json:"count"`   // number of values
+	Percent float64 `json:"percent"` // share of all values, 0-100
+}
+
+// Report is the summary of the statistics of a Collector, see
+// Collector.Report. It is rendered as text by String and as JSON
+// by encoding/json.
+type Report struct {
+	Total          int         `json:"total"`           // number of values
+	Kinds          int         `json:"kinds"`           // number of kinds
+	Top            []KindCount `json:"top"`             // most common kinds
+	PercentUnknown float64     `json:"percent_unknown"` // share of nil values
+}
+
+// TopN returns the n most common kinds of the observed values, by count
+// in descending order and then by name. A value of n less than or equal
+// to zero means all kinds.
+//
+// Example usage:
+//
+//	c := kind.NewCollector(0)
+//	for _, v := range []interface{}{1, 2, "a", nil} {
+//		c.Observe(v)
+//	}
+//
+//	for _, kc := range c.TopN(2) {
+//		fmt.Println(kc.Name, kc.Count, kc.Percent) // "int 2 50", "nil 1 25"
+//	}
+func (c *Collector) TopN(n int) []KindCount {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.topN(n)
+}
+
+// PercentUnknown returns the share, from 0 to 100, of the observed
+// values whose kind is unknown, that is, of the nil values.
+func (c *Collector) PercentUnknown() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.percentUnknown()
+}
+
+// Report returns the summary of the statistics
+// with the n most common kinds, see TopN.
+//
+// Example usage:
+//
+//	fmt.Println(c.Report(10))
+//	// total: 4, kinds: 3, unknown: 25.0%
+//	// int     2  50.0%
+//	// nil     1  25.0%
+//	// string  1  25.0%
+func (c *Collector) Report(n int) Report {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return Report{
+		Total:          c.total(),
+		Kinds:          len(c.summary),
+		Top:            c.topN(n),
+		PercentUnknown: c.percentUnknown(),
+	}
+}
+
+// String returns the report as text: the totals
+// and then one aligned line per kind.
+func (r Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "total: %d, kinds: %d, unknown: %.1f%%\n",
+		r.Total, r.Kinds, r.PercentUnknown)
+
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	for _, kc := range r.Top {
+		fmt.Fprintf(w, "%s\t%d\t%.1f%%\n", kc.Name, kc.Count, kc.Percent)
+	}
+
+	w.Flush()
+	return b.String()
+}
+
+// Reset removes the collected statistics.
+func (c *Collector) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.summary = nil
+}
+
+// total returns the number of the observed values.
+// The caller must hold the lock.
+func (c *Collector) total() int {
+	total := 0
+	for _, s := range c.summary {
+		total += s.Count
+	}
+
+	return total
+}
+
+// topN returns the n most common kinds.
+// The caller must hold the lock.
+func (c *Collector) topN(n int) []KindCount {
+	total := c.total()
+	counts := make([]KindCount, 0, len(c.summary))
+	for name, s := range c.summary {
+		counts = append(counts, KindCount{
+			Name:    name,
+			Count:   s.Count,
+			Percent: percent(s.Count, total),
+		})
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+
+		return counts[i].Name < counts[j].Name
+	})
+
+	if n > 0 && n < len(counts) {
+		counts = counts[:n]
+	}
+
+	return counts
+}
+
+// percentUnknown returns the share of the nil values.
+// The caller must hold the lock.
+func (c *Collector) percentUnknown() float64 {
+	s, ok := c.summary[nilNode.name]
+	if !ok {
+		return 0
+	}
+
+	return percent(s.Count, c.total())
+}
+
+// percent returns the share of n in total, from 0 to 100.
+func percent(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return float64(n) * 100 / float64(total)
+}
+
+// numberOf returns the value as float64 if it is a real number.
+func numberOf(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,192 @@
+package kind
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// TestCollector tests the Collector type.
+func TestCollector(t *testing.T) {
+	c := NewCollector(2)
+	values := []interface{}{
+		3, -1, 7, "", "a", "abcd", 2.5,
+		[]int{1, 2, 3}, []int{}, map[string]int{"a": 1}, nil,
+	}
+
+	for _, v := range values {
+		c.Observe(v)
+	}
+
+	expected := map[string]KindSummary{
+		"int": {Count: 3, Examples: []interface{}{3, -1}, Min: -1, Max: 7},
+		"string": {
+			Count:    3,
+			Examples: []interface{}{"", "a"},
+			Lengths:  []int{1, 1, 0, 1},
+			MinLen:   0,
+			MaxLen:   4,
+		},
+		"float64": {Count: 1, Examples: []interface{}{2.5}, Min: 2.5, Max: 2.5},
+		"[]int": {
+			Count:    2,
+			Examples: []interface{}{[]int{1, 2, 3}, []int{}},
+			Lengths:  []int{1, 0, 1},
+			MinLen:   0,
+			MaxLen:   3,
+		},
+		"map[string]int": {
+			Count:    1,
+			Examples: []interface{}{map[string]int{"a": 1}},
+			Lengths:  []int{0, 1},
+			MinLen:   1,
+			MaxLen:   1,
+		},
+		"nil": {Count: 1, Examples: []interface{}{nil}},
+	}
+
+	snapshot := c.Snapshot()
+	if !reflect.DeepEqual(snapshot, expected) {
+		t.Errorf("Expected %+v, but got %+v", expected, snapshot)
+	}
+
+	// The snapshot is a copy.
+	snapshot["string"].Lengths[0] = 100
+	if c.Snapshot()["string"].Lengths[0] != 1 {
+		t.Errorf("Snapshot shares the lengths with the collector")
+	}
+
+	c.Reset()
+	if len(c.Snapshot()) != 0 {
+		t.Errorf("Expected empty snapshot after reset")
+	}
+}
+
+// TestCollectorConcurrent tests the concurrent use of the Collector.
+func TestCollectorConcurrent(t *testing.T) {
+	var c Collector
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				c.Observe(i * j)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	s := c.Snapshot()["int"]
+	if s.Count != 800 || s.Min != 0 || s.Max != 7*99 || s.Examples != nil {
+		t.Errorf("Unexpected summary %+v", s)
+	}
+}
+
+// TestCollectorReport tests the TopN, PercentUnknown and Report methods.
+func TestCollectorReport(t *testing.T) {
+	var c Collector
+	if r := c.Report(3); r.Total != 0 || len(r.Top) != 0 || r.PercentUnknown != 0 {
+		t.Errorf("Expected empty report, but got %+v", r)
+	}
+
+	for _, v := range []interface{}{1, 2, 3, "a", "b", nil, 1.5, nil} {
+		c.Observe(v)
+	}
+
+	tests := []struct {
+		n        int
+		expected []KindCount
+	}{
+		{1, []KindCount{{"int", 3, 37.5}}},
+		{
+			3,
+			[]KindCount{{"int", 3, 37.5}, {"nil", 2, 25}, {"string", 2, 25}},
+		},
+		{
+			0,
+			[]KindCount{
+				{"int", 3, 37.5}, {"nil", 2, 25},
+				{"string", 2, 25}, {"float64", 1, 12.5},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		if top := c.TopN(tt.n); !reflect.DeepEqual(top, tt.expected) {
+			t.Errorf("TopN(%d): expected %v, but got %v", tt.n, tt.expected, top)
+		}
+	}
+
+	if p := c.PercentUnknown(); p != 25 {
+		t.Errorf("Expected 25%% unknown, but got %v", p)
+	}
+
+	r := c.Report(2)
+	text := "total: 8, kinds: 4, unknown: 25.0%\n" +
+		"int  3  37.5%\n" +
+		"nil  2  25.0%\n"
+	if r.String() != text {
+		t.Errorf("Expected text\n%s\nbut got\n%s", text, r)
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"total":8,"kinds":4,"top":[` +
+		`{"name":"int","count":3,"percent":37.5},` +
+		`{"name":"nil","count":2,"percent":25}],"percent_unknown":25}`
+	if string(data) != expected {
+		t.Errorf("Expected JSON %s, but got %s", expected, data)
+	}
+}
+
+// TestCollectorExamples tests the Collector.Examples method.
+func TestCollectorExamples(t *testing.T) {
+	c := NewCollector(3)
+	for _, v := range []interface{}{1, "a", 2, 3, 4, nil, "b"} {
+		c.Observe(v)
+	}
+
+	tests := []struct {
+		perKind  int
+		expected map[string][]interface{}
+	}{
+		{
+			1,
+			map[string][]interface{}{"int": {1}, "string": {"a"}, "nil": {nil}},
+		},
+		{
+			2,
+			map[string][]interface{}{"int": {1, 2}, "string": {"a", "b"}, "nil": {nil}},
+		},
+		{
+			0,
+			map[string][]interface{}{"int": {1, 2, 3}, "string": {"a", "b"}, "nil": {nil}},
+		},
+	}
+
+	for _, tt := range tests {
+		examples := c.Examples(tt.perKind)
+		if !reflect.DeepEqual(examples, tt.expected) {
+			t.Errorf("Examples(%d): expected %v, but got %v",
+				tt.perKind, tt.expected, examples)
+		}
+	}
+
+	// The examples are a copy.
+	c.Examples(0)["int"][0] = 100
+	if c.Examples(1)["int"][0] != 1 {
+		t.Error("Examples share the values with the collector")
+	}
+
+	var empty Collector
+	empty.Observe(1)
+	if examples := empty.Examples(1); len(examples) != 0 {
+		t.Errorf("Expected no examples, but got %v", examples)
+	}
+}
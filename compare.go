@@ -0,0 +1,116 @@
+package kind
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Compare returns an integer comparing two Kind instances in a total
+// order: -1 if a is less than b, 0 if they describe the same type and
+// +1 if a is greater than b. The stored values are not compared.
+//
+// The Kinds are ordered by the category of the outermost type first:
+//
+//	nil, undefined, bool, signed integers, unsigned integers, floats,
+//	complex numbers, string, array, slice, map, struct, pointer,
+//	interface, channel, function, unsafe pointer
+//
+// then by name, and types of the same name from different packages by
+// their package path. A nil *Kind is less than any Kind instance.
+//
+// Example usage:
+//
+//	fmt.Println(kind.Compare(kind.Of(1), kind.Of("a"))) // -1
+func Compare(a, b *Kind) int {
+	switch {
+	case a == b:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	if ca, cb := a.category(), b.category(); ca != cb {
+		if ca < cb {
+			return -1
+		}
+
+		return 1
+	}
+
+	if c := strings.Compare(a.name, b.name); c != 0 {
+		return c
+	}
+
+	if a.rtype == nil || b.rtype == nil {
+		return 0
+	}
+
+	return strings.Compare(canonicalName(a.rtype), canonicalName(b.rtype))
+}
+
+// Kinds attaches the methods of sort.Interface to []*Kind,
+// sorting in the order defined by Compare.
+//
+// Example usage:
+//
+//	ks := kind.Kinds{kind.Of("a"), kind.Of(1), kind.Of(true)}
+//	sort.Sort(ks)
+//	fmt.Printf("%s\n", ks) // [bool int string]
+type Kinds []*Kind
+
+// Len returns the number of Kinds.
+func (ks Kinds) Len() int { return len(ks) }
+
+// Less reports whether the i'th Kind is ordered before the j'th Kind.
+func (ks Kinds) Less(i, j int) bool { return Compare(ks[i], ks[j]) < 0 }
+
+// Swap swaps the i'th and j'th Kinds.
+func (ks Kinds) Swap(i, j int) { ks[i], ks[j] = ks[j], ks[i] }
+
+// category returns the rank of the outermost type category.
+func (k *Kind) category() int {
+	if k.rtype == nil {
+		if k.isNil {
+			return 0
+		}
+
+		return 1
+	}
+
+	switch k.rtype.Kind() {
+	case reflect.Bool:
+		return 2
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		return 3
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return 4
+	case reflect.Float32, reflect.Float64:
+		return 5
+	case reflect.Complex64, reflect.Complex128:
+		return 6
+	case reflect.String:
+		return 7
+	case reflect.Array:
+		return 8
+	case reflect.Slice:
+		return 9
+	case reflect.Map:
+		return 10
+	case reflect.Struct:
+		return 11
+	case reflect.Ptr:
+		return 12
+	case reflect.Interface:
+		return 13
+	case reflect.Chan:
+		return 14
+	case reflect.Func:
+		return 15
+	}
+
+	return 16
+}
@@ -0,0 +1,66 @@
+package kind
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"unsafe"
+)
+
+// TestCompare tests the Compare function.
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     *Kind
+		expected int
+	}{
+		{"same type", Of(1), Of(2), 0},
+		{"nil kinds", nil, nil, 0},
+		{"nil kind", nil, Of(nil), -1},
+		{"nil value", Of(nil), Of(false), -1},
+		{"category", Of("a"), Of(1), 1},
+		{"signed before unsigned", Of(int64(1)), Of(uint8(1)), -1},
+		{"name", Of(int16(1)), Of(int8(1)), -1},
+		{"pointer after struct", Of(&Field{}), Of(Field{}), 1},
+		{"undefined", &Kind{typeNode: &typeNode{name: "T", isUndefined: true}}, Of(true), -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Compare(tt.a, tt.b); got != tt.expected {
+				t.Errorf("Expected %d, but got %d", tt.expected, got)
+			}
+
+			if got := Compare(tt.b, tt.a); got != -tt.expected {
+				t.Errorf("Expected reversed %d, but got %d", -tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestKindsSort tests sorting of Kinds.
+func TestKindsSort(t *testing.T) {
+	ks := Kinds{
+		Of(func() {}), Of(unsafe.Pointer(nil)), Of(make(chan int)),
+		For[error](), Of(&Field{}), Of(Field{}), Of(map[string]int{}),
+		Of([]int{}), Of([1]int{}), Of("a"), Of(1i), Of(1.0), Of(uint(1)),
+		Of(1), Of(true), Of(nil),
+	}
+
+	sort.Sort(ks)
+
+	names := make([]string, len(ks))
+	for i, k := range ks {
+		names[i] = k.Name()
+	}
+
+	expected := []string{
+		"nil", "bool", "int", "uint", "float64", "complex128", "string",
+		"[1]int", "[]int", "map[string]int", "kind.Field", "*kind.Field",
+		"error", "chan int", "func()", "unsafe.Pointer",
+	}
+
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected %v, but got %v", expected, names)
+	}
+}
@@ -0,0 +1,294 @@
+package kind
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// CompatMode defines the rules of Compatible.
+type CompatMode int
+
+const (
+	// CompatStrict requires the shapes to match exactly: the same basic
+	// kinds, the same fields and the same pointers, while named types
+	// are compared by their structure.
+	CompatStrict CompatMode = iota
+
+	// CompatWire follows what a tolerant wire decoder, such as
+	// encoding/json, accepts: numbers can be widened, pointers are
+	// optional, arrays can be decoded into slices and into longer arrays,
+	// fields unknown to the consumer are ignored and optional consumer
+	// fields, see Field.IsOptional, can be missing.
+	CompatWire
+)
+
+// Violation describes why data of the producer Kind cannot be
+// decoded into the consumer Kind.
+type Violation struct {
+	Path     string // path of the node, empty for the root, e.g. "items[].id"
+	Producer string // type name on the producer side
+	Consumer string // type name on the consumer side
+	Reason   string // description of the violation
+}
+
+// Compatible reports whether data shaped like the producer Kind can be
+// safely decoded into the consumer Kind under the mode rules, and returns
+// the list of violations otherwise. Struct fields are matched by their
+// json names, falling back to their Go names, and the fields of embedded
+// structs are promoted as by encoding/json; the violation paths use the
+// same names, "[]" for the elements of sequences and map values, and
+// "[key]" for map keys.
+//
+// Example usage:
+//
+//	type V1 struct {
+//		ID   int32  `json:"id"`
+//		Name string `json:"name"`
+//	}
+//
+//	type V2 struct {
+//		ID    int64   `json:"id"`
+//		Name  string  `json:"name"`
+//		Email *string `json:"email"`
+//	}
+//
+//	ok, _ := kind.Compatible(kind.For[V1](), kind.For[V2](), kind.CompatWire)
+//	fmt.Println(ok) // true
+//
+//	ok, vs := kind.Compatible(kind.For[V2](), kind.For[V1](), kind.CompatWire)
+//	fmt.Println(ok, vs[0].Path, vs[0].Reason) // false id cannot narrow int64 to int32
+func Compatible(producer, consumer *Kind, mode CompatMode) (bool, []Violation) {
+	c := &compatChecker{mode: mode, seen: map[[2]reflect.Type]bool{}}
+	switch {
+	case producer == nil || consumer == nil:
+		c.violate("", "", "", "missing kind")
+	case producer.rtype == nil || consumer.rtype == nil:
+		if producer.name != consumer.name {
+			c.violate("", producer.name, consumer.name, "no type information")
+		}
+	default:
+		c.check(producer.rtype, consumer.rtype, "")
+	}
+
+	return len(c.violations) == 0, c.violations
+}
+
+// The compatChecker collects the violations of compatibility.
+type compatChecker struct {
+	mode       CompatMode
+	violations []Violation
+	seen       map[[2]reflect.Type]bool // pairs of types being checked
+}
+
+// violate adds the violation.
+func (c *compatChecker) violate(path, producer, consumer, reason string) {
+	c.violations = append(c.violations, Violation{
+		Path:     path,
+		Producer: producer,
+		Consumer: consumer,
+		Reason:   reason,
+	})
+}
+
+// check checks that data of the type p can be decoded into the type t.
+func (c *compatChecker) check(p, t reflect.Type, path string) {
+	pair := [2]reflect.Type{p, t}
+	if p == t || c.seen[pair] {
+		return
+	}
+
+	c.seen[pair] = true
+	defer delete(c.seen, pair)
+
+	mismatch := func(reason string) {
+		c.violate(path, p.String(), t.String(), reason)
+	}
+
+	if t.Kind() == reflect.Interface {
+		if !p.Implements(t) && !(c.mode == CompatWire && t.NumMethod() == 0) {
+			mismatch(fmt.Sprintf("%s does not implement %s", p, t))
+		}
+
+		return
+	}
+
+	if p.Kind() == reflect.Ptr || t.Kind() == reflect.Ptr {
+		switch {
+		case p.Kind() == reflect.Ptr && t.Kind() == reflect.Ptr:
+			c.check(p.Elem(), t.Elem(), path)
+		case c.mode == CompatStrict:
+			mismatch(fmt.Sprintf("expected %s, got %s", t, p))
+		case p.Kind() == reflect.Ptr:
+			c.check(p.Elem(), t, path)
+		default:
+			c.check(p, t.Elem(), path)
+		}
+
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16,
+		reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Complex64, reflect.Complex128:
+		switch {
+		case p.Kind() == t.Kind():
+		case c.mode == CompatWire && numericWidens(p, t):
+		case numericWidens(p, t):
+			mismatch(fmt.Sprintf("expected %s, got %s", t, p))
+		case isNumericKind(p.Kind()):
+			mismatch(fmt.Sprintf("cannot narrow %s to %s", p, t))
+		default:
+			mismatch(fmt.Sprintf("expected %s, got %s", t, p))
+		}
+	case reflect.Slice, reflect.Array:
+		if p.Kind() != reflect.Slice && p.Kind() != reflect.Array {
+			mismatch(fmt.Sprintf("expected %s, got %s", t, p))
+			return
+		}
+
+		switch {
+		case p.Kind() == t.Kind() && (t.Kind() == reflect.Slice || p.Len() == t.Len()):
+		case c.mode == CompatStrict:
+			mismatch(fmt.Sprintf("expected %s, got %s", t, p))
+			return
+		case t.Kind() == reflect.Array && p.Kind() == reflect.Slice:
+			mismatch(fmt.Sprintf("slice may not fit %s", t))
+			return
+		case t.Kind() == reflect.Array && p.Len() > t.Len():
+			mismatch(fmt.Sprintf("%s does not fit %s", p, t))
+			return
+		}
+
+		c.check(p.Elem(), t.Elem(), path+"[]")
+	case reflect.Map:
+		if p.Kind() != reflect.Map {
+			mismatch(fmt.Sprintf("expected %s, got %s", t, p))
+			return
+		}
+
+		c.check(p.Key(), t.Key(), path+"[key]")
+		c.check(p.Elem(), t.Elem(), path+"[]")
+	case reflect.Struct:
+		if p.Kind() != reflect.Struct {
+			mismatch(fmt.Sprintf("expected %s, got %s", t, p))
+			return
+		}
+
+		c.checkStruct(p, t, path)
+	case reflect.Bool, reflect.String:
+		if p.Kind() != t.Kind() {
+			mismatch(fmt.Sprintf("expected %s, got %s", t, p))
+		}
+	default:
+		// Channels, functions and unsafe pointers must be identical.
+		mismatch(fmt.Sprintf("expected %s, got %s", t, p))
+	}
+}
+
+// checkStruct checks the fields of the struct types.
+func (c *compatChecker) checkStruct(p, t reflect.Type, path string) {
+	pfields := jsonFields(p)
+	matched := make(map[string]bool, len(pfields))
+	for _, tf := range jsonFields(t) {
+		fpath := fieldPath(path, tf.name)
+		pf, ok := lookupJSONField(pfields, tf)
+		if !ok {
+			if c.mode == CompatStrict || !newField(tf.field).IsOptional() {
+				c.violate(fpath, "", tf.field.Type.String(), "missing field")
+			}
+
+			continue
+		}
+
+		matched[pf.name] = true
+		c.check(pf.field.Type, tf.field.Type, fpath)
+	}
+
+	if c.mode == CompatStrict {
+		for _, pf := range pfields {
+			if !matched[pf.name] {
+				c.violate(fieldPath(path, pf.name), pf.field.Type.String(), "",
+					"unknown field")
+			}
+		}
+	}
+}
+
+// isNumericKind returns true if the kind is a number.
+func isNumericKind(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Complex128
+}
+
+// numericWidens returns true if every value of the numeric type p can be
+// represented exactly by the numeric type t. The int and uint types are
+// considered to be 64 bits wide.
+func numericWidens(p, t reflect.Type) bool {
+	pk, tk := p.Kind(), t.Kind()
+	if !isNumericKind(pk) || !isNumericKind(tk) {
+		return false
+	}
+
+	pbits, tbits := numericBits(pk), numericBits(tk)
+	switch {
+	case isSignedKind(pk):
+		switch {
+		case isSignedKind(tk):
+			return tbits >= pbits
+		case isFloatOrComplexKind(tk):
+			return mantissaBits(tk) >= pbits
+		}
+	case isFloatOrComplexKind(pk):
+		return isFloatOrComplexKind(tk) && mantissaBits(tk) >= mantissaBits(pk) &&
+			!(tk <= reflect.Float64 && pk >= reflect.Complex64)
+	default: // unsigned
+		switch {
+		case isSignedKind(tk):
+			return tbits > pbits
+		case isFloatOrComplexKind(tk):
+			return mantissaBits(tk) >= pbits
+		default:
+			return tbits >= pbits
+		}
+	}
+
+	return false
+}
+
+// numericBits returns the size of the numeric kind in bits.
+func numericBits(k reflect.Kind) int {
+	switch k {
+	case reflect.Int8, reflect.Uint8:
+		return 8
+	case reflect.Int16, reflect.Uint16:
+		return 16
+	case reflect.Int32, reflect.Uint32, reflect.Float32:
+		return 32
+	case reflect.Complex128:
+		return 128
+	}
+
+	return 64
+}
+
+// mantissaBits returns the precision of the floating-point kind in bits.
+func mantissaBits(k reflect.Kind) int {
+	if k == reflect.Float32 || k == reflect.Complex64 {
+		return 24
+	}
+
+	return 53
+}
+
+// isSignedKind returns true if the kind is a signed integer.
+func isSignedKind(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Int64
+}
+
+// isFloatOrComplexKind returns true if the kind is
+// a floating-point or complex number.
+func isFloatOrComplexKind(k reflect.Kind) bool {
+	return k >= reflect.Float32 && k <= reflect.Complex128
+}
@@ -0,0 +1,165 @@
+package kind
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestCompatible tests the Compatible function.
+func TestCompatible(t *testing.T) {
+	type Item struct {
+		ID    int32   `json:"id"`
+		Price float32 `json:"price"`
+	}
+
+	type V1 struct {
+		ID    int32  `json:"id"`
+		Name  string `json:"name"`
+		Items []Item `json:"items"`
+		Old   bool   `json:"old"`
+	}
+
+	type ItemV2 struct {
+		ID    int64   `json:"id"`
+		Price float64 `json:"price"`
+	}
+
+	type V2 struct {
+		ID    int64     `json:"id"`
+		Name  *string   `json:"name"`
+		Items []*ItemV2 `json:"items"`
+		Email string    `json:"email,omitempty"`
+		Tags  []string  `json:"tags"`
+	}
+
+	type Node struct {
+		Value    int     `json:"value"`
+		Children []*Node `json:"children"`
+	}
+
+	type NodeV2 struct {
+		Value    int64     `json:"value"`
+		Children []*NodeV2 `json:"children"`
+	}
+
+	type Base struct {
+		ID int64 `json:"id"`
+	}
+
+	type Embedded struct {
+		Base
+		Name string `json:"name"`
+	}
+
+	tests := []struct {
+		name       string
+		producer   *Kind
+		consumer   *Kind
+		mode       CompatMode
+		violations []Violation
+	}{
+		{"same type", For[V1](), For[V1](), CompatStrict, nil},
+		{"wire widening", For[V1](), For[V2](), CompatWire, nil},
+		{
+			name:     "wire narrowing",
+			producer: For[V2](),
+			consumer: For[V1](),
+			mode:     CompatWire,
+			violations: []Violation{
+				{"id", "int64", "int32", "cannot narrow int64 to int32"},
+				{"items[].id", "int64", "int32", "cannot narrow int64 to int32"},
+				{"items[].price", "float64", "float32", "cannot narrow float64 to float32"},
+				{"old", "", "bool", "missing field"},
+			},
+		},
+		{
+			name:     "strict",
+			producer: For[V1](),
+			consumer: For[V2](),
+			mode:     CompatStrict,
+			violations: []Violation{
+				{"id", "int32", "int64", "expected int64, got int32"},
+				{"name", "string", "*string", "expected *string, got string"},
+				{"items[]", "kind.Item", "*kind.ItemV2", "expected *kind.ItemV2, got kind.Item"},
+				{"email", "", "string", "missing field"},
+				{"tags", "", "[]string", "missing field"},
+				{"old", "bool", "", "unknown field"},
+			},
+		},
+		{"recursive", For[Node](), For[NodeV2](), CompatWire, nil},
+		{"embedded into flat", For[Embedded](), For[V2](), CompatWire, nil},
+		{
+			name:     "flat into embedded",
+			producer: For[V1](),
+			consumer: For[Embedded](),
+			mode:     CompatStrict,
+			violations: []Violation{
+				{"id", "int32", "int64", "expected int64, got int32"},
+				{"items", "[]kind.Item", "", "unknown field"},
+				{"old", "bool", "", "unknown field"},
+			},
+		},
+		{"map", For[map[string]int8](), For[map[string]float32](), CompatWire, nil},
+		{
+			name:     "map key",
+			producer: For[map[int64]int](),
+			consumer: For[map[string]int](),
+			mode:     CompatWire,
+			violations: []Violation{
+				{"[key]", "int64", "string", "expected string, got int64"},
+			},
+		},
+		{"array into slice", For[[2]int](), For[[]int](), CompatWire, nil},
+		{"array into array", For[[2]int](), For[[3]int](), CompatWire, nil},
+		{
+			name:     "slice into array",
+			producer: For[[]int](),
+			consumer: For[[3]int](),
+			mode:     CompatWire,
+			violations: []Violation{
+				{"", "[]int", "[3]int", "slice may not fit [3]int"},
+			},
+		},
+		{"uint into int", For[uint32](), For[int64](), CompatWire, nil},
+		{
+			name:     "uint into same int",
+			producer: For[uint64](),
+			consumer: For[int64](),
+			mode:     CompatWire,
+			violations: []Violation{
+				{"", "uint64", "int64", "cannot narrow uint64 to int64"},
+			},
+		},
+		{"any", For[V1](), For[interface{}](), CompatWire, nil},
+		{
+			name:     "channel",
+			producer: For[chan int](),
+			consumer: For[chan int64](),
+			mode:     CompatWire,
+			violations: []Violation{
+				{"", "chan int", "chan int64", "expected chan int64, got chan int"},
+			},
+		},
+		{
+			name:       "no type",
+			producer:   Of(nil),
+			consumer:   Of(1),
+			mode:       CompatWire,
+			violations: []Violation{{"", "nil", "int", "no type information"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, violations := Compatible(tt.producer, tt.consumer, tt.mode)
+			if ok != (len(tt.violations) == 0) {
+				t.Errorf("Expected compatible %v, but got %v",
+					len(tt.violations) == 0, ok)
+			}
+
+			if !reflect.DeepEqual(violations, tt.violations) {
+				t.Errorf("Expected %+v, but got %+v", tt.violations, violations)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package kind
+
+import "reflect"
+
+// Constraint is a type set of the standard generic constraints, such as
+// the ones of golang.org/x/exp/constraints and cmp.Ordered.
+type Constraint int
+
+const (
+	// Signed is the set of the signed integer types.
+	Signed Constraint = iota
+
+	// Unsigned is the set of the unsigned integer types, including
+	// uintptr.
+	Unsigned
+
+	// Integer is the set of the signed and unsigned integer types.
+	Integer
+
+	// Float is the set of the floating-point types.
+	Float
+
+	// Complex is the set of the complex types.
+	Complex
+
+	// Ordered is the set of the types that support the < operator:
+	// the integer, floating-point and string types.
+	Ordered
+)
+
+// String returns the name of the constraint.
+func (c Constraint) String() string {
+	switch c {
+	case Signed:
+		return "Signed"
+	case Unsigned:
+		return "Unsigned"
+	case Integer:
+		return "Integer"
+	case Float:
+		return "Float"
+	case Complex:
+		return "Complex"
+	case Ordered:
+		return "Ordered"
+	}
+
+	return "unknown"
+}
+
+// Satisfies returns true if the type represented by the Kind instance
+// is in the type set of the constraint. Like the constraints, whose
+// terms are ~int, ~string and so on, it accepts named types by their
+// underlying types, so a dynamic value can be sent down the same code
+// path a generic function would accept it in.
+//
+// Example usage:
+//
+//	type Celsius float64
+//
+//	k := kind.Of(Celsius(36.6))
+//	fmt.Println(k.Satisfies(kind.Float))   // true
+//	fmt.Println(k.Satisfies(kind.Ordered)) // true
+//	fmt.Println(k.Satisfies(kind.Integer)) // false
+func (k *Kind) Satisfies(c Constraint) bool {
+	switch rk := k.ReflectKind(); c {
+	case Signed:
+		return rk >= reflect.Int && rk <= reflect.Int64
+	case Unsigned:
+		return rk >= reflect.Uint && rk <= reflect.Uintptr
+	case Integer:
+		return isIntegerKind(rk)
+	case Float:
+		return rk == reflect.Float32 || rk == reflect.Float64
+	case Complex:
+		return rk == reflect.Complex64 || rk == reflect.Complex128
+	case Ordered:
+		return isOrderedKind(rk)
+	}
+
+	return false
+}
+
+// isOrderedKind returns true if the values of the kind support
+// the < operator.
+func isOrderedKind(rk reflect.Kind) bool {
+	return isIntegerKind(rk) || rk == reflect.Float32 ||
+		rk == reflect.Float64 || rk == reflect.String
+}
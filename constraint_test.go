@@ -0,0 +1,54 @@
+package kind
+
+import (
+	"testing"
+	"time"
+)
+
+// TestSatisfies tests the Satisfies method.
+func TestSatisfies(t *testing.T) {
+	type Celsius float64
+	type ID string
+
+	all := []Constraint{Signed, Unsigned, Integer, Float, Complex, Ordered}
+	tests := []struct {
+		name      string
+		input     interface{}
+		satisfied []Constraint
+	}{
+		{"int", 1, []Constraint{Signed, Integer, Ordered}},
+		{"int8", int8(1), []Constraint{Signed, Integer, Ordered}},
+		{"uint16", uint16(1), []Constraint{Unsigned, Integer, Ordered}},
+		{"uintptr", uintptr(1), []Constraint{Unsigned, Integer, Ordered}},
+		{"float32", float32(1), []Constraint{Float, Ordered}},
+		{"complex", 1i, []Constraint{Complex}},
+		{"string", "a", []Constraint{Ordered}},
+		{"named float", Celsius(36.6), []Constraint{Float, Ordered}},
+		{"named string", ID("a"), []Constraint{Ordered}},
+		{"duration", time.Second, []Constraint{Signed, Integer, Ordered}},
+		{"bool", true, nil},
+		{"slice", []int{}, nil},
+		{"pointer", new(int), nil},
+		{"nil", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := Of(tt.input)
+			for _, c := range all {
+				expected := false
+				for _, s := range tt.satisfied {
+					expected = expected || s == c
+				}
+
+				if got := k.Satisfies(c); got != expected {
+					t.Errorf("Expected %v for %s, but got %v", expected, c, got)
+				}
+			}
+		})
+	}
+
+	if Of(1).Satisfies(Constraint(-1)) {
+		t.Error("Expected false for an unknown constraint")
+	}
+}
@@ -0,0 +1,506 @@
+package kind
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var (
+	// ErrTruncated is returned by Walk when the walk is stopped
+	// by the limits set by WithMaxDepth or WithMaxNodes.
+	ErrTruncated = errors.New("kind: analysis truncated")
+
+	// ErrInterfaceHole is returned by TryDeepOf with the StrictShape
+	// option for the parts of the type that are empty interfaces.
+	ErrInterfaceHole = errors.New("kind: empty interface in strict shape")
+)
+
+// DefaultMaxDepth is the default depth limit of DeepOf.
+const DefaultMaxDepth = 32
+
+// DeepOption configures the deep analysis of DeepOf and Walk.
+type DeepOption func(*deepConfig)
+
+// The deepConfig holds the limits of the deep analysis.
+type deepConfig struct {
+	maxDepth int       // maximum nesting level of nodes, 0 - unlimited
+	maxNodes int       // maximum number of nodes, 0 - unlimited
+	cycles   CycleMode // handling of pointer cycles in values
+	strict   bool      // empty interfaces are errors
+	static   bool      // interface values are not resolved
+	arena    int       // slab size of DeepOf nodes, 0 - no arena
+}
+
+// WithMaxDepth limits the nesting level of the analyzed nodes: the
+// children of the nodes at the given level are not analyzed. A value
+// less than or equal to zero means no limit. The default is
+// DefaultMaxDepth for DeepOf and no limit for Walk.
+func WithMaxDepth(n int) DeepOption {
+	return func(c *deepConfig) {
+		c.maxDepth = n
+	}
+}
+
+// WithMaxNodes limits the number of the analyzed nodes, including the
+// root. A value less than or equal to zero means no limit, which is
+// the default.
+func WithMaxNodes(n int) DeepOption {
+	return func(c *deepConfig) {
+		c.maxNodes = n
+	}
+}
+
+// WithArena makes DeepOf allocate the Kinds of the tree in slabs of
+// n nodes instead of one by one, which reduces the number of allocations
+// and the GC pressure when schemas are built from big structs. The nodes
+// of a slab are freed together, when none of them is used any more, so
+// a Kind kept from the tree keeps its whole slab alive. A value less than
+// or equal to zero means no arena, which is the default.
+//
+// Example usage:
+//
+//	k := kind.DeepOf(Order{}, kind.WithArena(256))
+func WithArena(n int) DeepOption {
+	return func(c *deepConfig) {
+		c.arena = n
+	}
+}
+
+// StrictShape makes TryDeepOf reject the types that contain empty
+// interfaces, such as interface{} fields or []any elements, whose shape
+// is unknown until run time, so schemas generated from them are
+// meaningless. DeepOf builds the Kind regardless of the option.
+func StrictShape() DeepOption {
+	return func(c *deepConfig) {
+		c.strict = true
+	}
+}
+
+// ResolveDynamic sets whether Walk analyzes the values of interface-typed
+// nodes, such as interface{} struct fields and []any elements, by their
+// dynamic types, which is the default. If resolve is false, Walk visits
+// such a node with the Kind of its static interface type, holding the
+// value, and does not descend into it, so the node stays opaque.
+func ResolveDynamic(resolve bool) DeepOption {
+	return func(c *deepConfig) {
+		c.static = !resolve
+	}
+}
+
+// CycleMode defines how Walk handles a pointer that refers back to
+// a node on the current path, which would make the walk endless.
+type CycleMode int
+
+const (
+	// CycleSkip visits the pointer as a node, but does not follow it.
+	CycleSkip CycleMode = iota
+
+	// CycleError stops the walk with the ErrCyclicValue error.
+	CycleError
+
+	// CycleMark visits a CycleRef marker instead of the pointer,
+	// see Kind.AsCycleRef.
+	CycleMark
+)
+
+// CycleRef is the marker visited by Walk in the CycleMark mode in place
+// of a pointer that refers back to a node on the current path.
+type CycleRef struct {
+	Path string // path of the node the pointer refers to
+}
+
+// WithCycleMode sets how Walk handles pointer cycles in values.
+// The default is CycleSkip.
+func WithCycleMode(m CycleMode) DeepOption {
+	return func(c *deepConfig) {
+		c.cycles = m
+	}
+}
+
+// AsCycleRef returns the CycleRef marker stored in the Kind instance
+// by Walk in the CycleMark mode.
+//
+// Example usage:
+//
+//	kind.Walk(list, func(path string, k *kind.Kind) error {
+//		if ref, ok := k.AsCycleRef(); ok {
+//			fmt.Printf("%s refers back to %q\n", path, ref.Path)
+//		}
+//		return nil
+//	}, kind.WithCycleMode(kind.CycleMark))
+func (k *Kind) AsCycleRef() (CycleRef, bool) {
+	ref, ok := k.value.(CycleRef)
+	return ref, ok
+}
+
+// newDeepConfig returns the configuration with the options applied.
+func newDeepConfig(maxDepth int, opts []DeepOption) deepConfig {
+	c := deepConfig{maxDepth: maxDepth}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
+
+// exceeded returns true if the node at the depth must not have its
+// children analyzed, because there would be n nodes with them.
+func (c deepConfig) exceeded(depth, n int) bool {
+	return c.maxDepth > 0 && depth >= c.maxDepth ||
+		c.maxNodes > 0 && n > c.maxNodes
+}
+
+// DeepOf works like Of, but analyzes the whole type tree at once instead
+// of on first access: the kinds of map keys and values, of channel and
+// iterator elements, and of struct fields, which are then returned by
+// Fields, Field and FieldByName. The analysis is bounded by the
+// WithMaxDepth and WithMaxNodes options; the Kinds whose children are
+// not analyzed because of the limits, and all their ancestors, are
+// marked as Truncated. The analysis of a recursive type stops where the
+// type recurs, see IsRecursive.
+//
+// Example usage:
+//
+//	type Config struct {
+//		Servers map[string][]string
+//	}
+//
+//	k := kind.DeepOf(Config{}, kind.WithMaxDepth(1))
+//	fmt.Println(k.Truncated()) // true
+func DeepOf(v interface{}, opts ...DeepOption) *Kind {
+	if v == nil {
+		return Of(nil)
+	}
+
+	b := newDeepBuilder(opts)
+	k := b.build(reflect.TypeOf(v), 0)
+	k.value = v
+
+	return k
+}
+
+// TryDeepOf is like DeepOf, but returns an error if the type cannot be
+// fully represented, see TryOf, or, with the StrictShape option, if it
+// contains empty interfaces. The latter errors wrap ErrInterfaceHole and
+// name the paths of the interfaces in the format of CyclePath, joined by
+// dots; the elements of pointers, slices and arrays share the path.
+//
+// Example usage:
+//
+//	type Event struct {
+//		Name    string
+//		Payload map[string]interface{}
+//	}
+//
+//	_, err := kind.TryDeepOf(Event{}, kind.StrictShape())
+//	fmt.Println(err) // kind: empty interface in strict shape: Payload.value
+func TryDeepOf(v interface{}, opts ...DeepOption) (*Kind, error) {
+	if v == nil {
+		return Of(nil), nil
+	}
+
+	if err := checkSupport(reflect.TypeOf(v)); err != nil {
+		return nil, err
+	}
+
+	b := newDeepBuilder(opts)
+	k := b.build(reflect.TypeOf(v), 0)
+	if len(b.holes) > 0 {
+		errs := make([]error, len(b.holes))
+		for i, path := range b.holes {
+			errs[i] = fmt.Errorf("%w: %s", ErrInterfaceHole, path)
+		}
+
+		return nil, errors.Join(errs...)
+	}
+
+	k.value = v
+	return k, nil
+}
+
+// Truncated returns true if the analysis of the Kind instance or
+// of its children was stopped by the limits of DeepOf.
+func (k *Kind) Truncated() bool {
+	return k.truncated
+}
+
+// IsRecursive returns true if the Kind instance built by DeepOf is on
+// a cycle of a recursive type, e.g. type Tree struct{ Children []Tree }.
+// The Kind where the cycle closes, the back-edge, has no children.
+//
+// Example usage:
+//
+//	type Tree struct {
+//		Children []Tree
+//	}
+//
+//	k := kind.DeepOf(Tree{})
+//	fmt.Println(k.IsRecursive(), k.CyclePath()) // true [Children]
+func (k *Kind) IsRecursive() bool {
+	return k.cycle != nil
+}
+
+// CyclePath returns the path from the Kind instance built by DeepOf to
+// the next occurrence of its own type on the cycle: the names of struct
+// fields and "key" or "value" for the children of maps, channels and
+// iterators. It returns nil if the Kind is not recursive.
+func (k *Kind) CyclePath() []string {
+	return append([]string(nil), k.cycle...)
+}
+
+// The deepBuilder builds the Kind trees for DeepOf.
+type deepBuilder struct {
+	config deepConfig
+	nodes  int         // number of built and reserved Kinds
+	stack  []deepFrame // Kinds on the current path
+	path   []string    // segments between the Kinds on the stack
+	holes  []string    // paths of empty interfaces in strict mode
+	arena  *deepArena  // allocator of the Kinds, nil - no arena
+}
+
+// newDeepBuilder returns the builder with the options applied.
+func newDeepBuilder(opts []DeepOption) *deepBuilder {
+	b := &deepBuilder{config: newDeepConfig(DefaultMaxDepth, opts), nodes: 1}
+	if b.config.arena > 0 {
+		b.arena = &deepArena{size: b.config.arena}
+	}
+
+	return b
+}
+
+// The deepFrame is a Kind on the current path of deepBuilder.
+type deepFrame struct {
+	t reflect.Type // type that the children are built from
+	k *Kind
+}
+
+// build returns the Kind with all children built. The Kind itself
+// must be already counted in nodes.
+func (b *deepBuilder) build(t reflect.Type, depth int) *Kind {
+	k := b.arena.newKind(t)
+	if b.config.strict && isEmptyInterface(t) {
+		b.holes = append(b.holes, strings.Join(b.path, "."))
+	}
+
+	// The children of the Kind of a pointer, slice or array chain, such
+	// as []map[string]T, are the ones of the type at the end of it.
+	end := chainEnd(t)
+	key, value := childTypes(end)
+	st := k.structType()
+	children := 0
+	if key != nil {
+		children++
+	}
+
+	if value != nil {
+		children++
+	}
+
+	if st != nil {
+		children += st.NumField()
+	}
+
+	if children == 0 {
+		return k
+	}
+
+	ct := end
+	if st != nil {
+		ct = st
+	}
+
+	if b.closeCycle(ct, k) {
+		k.children = b.arena.newChildren(nil, nil)
+		return k
+	}
+
+	if b.config.exceeded(depth, b.nodes+children) {
+		k.truncated = true
+		k.children = b.arena.newChildren(nil, nil)
+		return k
+	}
+
+	b.nodes += children
+	b.stack = append(b.stack, deepFrame{t: ct, k: k})
+	defer func() { b.stack = b.stack[:len(b.stack)-1] }()
+
+	var keyKind, valueKind *Kind
+	if key != nil {
+		keyKind = b.child(key, "key", depth)
+	}
+
+	if value != nil {
+		valueKind = b.child(value, "value", depth)
+	}
+
+	k.children = b.arena.newChildren(keyKind, valueKind)
+	for _, child := range []*Kind{keyKind, valueKind} {
+		if child != nil && child.truncated {
+			k.truncated = true
+		}
+	}
+
+	if st != nil {
+		k.children.fields = b.arena.newFields(st.NumField())
+		for i := range k.children.fields {
+			f := b.child(st.Field(i).Type, st.Field(i).Name, depth)
+			k.children.fields[i] = f
+			k.truncated = k.truncated || f.truncated
+		}
+	}
+
+	return k
+}
+
+// chainEnd returns the type at the end of the chain of pointers, slices
+// and arrays starting with the type, or the type itself if it does not
+// start a chain. A chain that leads back to one of its types, such as
+// type P *P, ends with the last type before it repeats.
+func chainEnd(t reflect.Type) reflect.Type {
+	var chain []reflect.Type
+	for {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array:
+		default:
+			return t
+		}
+
+		chain = append(chain, t)
+		elem := t.Elem()
+		for _, c := range chain {
+			if c == elem {
+				return t
+			}
+		}
+
+		t = elem
+	}
+}
+
+// child builds the child Kind reached by the path segment.
+func (b *deepBuilder) child(t reflect.Type, segment string, depth int) *Kind {
+	b.path = append(b.path, segment)
+	defer func() { b.path = b.path[:len(b.path)-1] }()
+
+	return b.build(t, depth+1)
+}
+
+// closeCycle returns true if the Kinds on the stack already include one
+// built from the type, so k is the back-edge of a cycle. It sets the
+// cycle paths of the Kinds on the cycle that are not on another cycle.
+func (b *deepBuilder) closeCycle(t reflect.Type, k *Kind) bool {
+	start := -1
+	for i, f := range b.stack {
+		if f.t == t {
+			start = i
+			break
+		}
+	}
+
+	if start < 0 {
+		return false
+	}
+
+	cycle := b.path[start:]
+	for i := start; i < len(b.stack); i++ {
+		if f := b.stack[i]; f.k.cycle == nil {
+			n := i - start
+			f.k.cycle = append(append([]string{}, cycle[n:]...), cycle[:n]...)
+		}
+	}
+
+	k.cycle = append([]string{}, cycle...)
+	return true
+}
+
+// The deepArena allocates the Kinds of a DeepOf tree, their child Kinds
+// and field lists from slabs. The methods of a nil arena allocate each
+// value separately.
+type deepArena struct {
+	size     int          // number of values in a slab
+	nodes    []kindNode   // free Kinds of the current slab
+	children []childKinds // free child Kinds of the current slab
+	fields   []*Kind      // free field list space of the current slab
+}
+
+// newKind returns the Kind of the type.
+func (a *deepArena) newKind(t reflect.Type) *Kind {
+	if a == nil {
+		return buildKind(t)
+	}
+
+	if len(a.nodes) == 0 {
+		a.nodes = make([]kindNode, a.size)
+	}
+
+	b := &a.nodes[0]
+	a.nodes = a.nodes[1:]
+
+	return b.init(t)
+}
+
+// newChildren returns the already built child Kinds, see newChildKinds.
+func (a *deepArena) newChildren(key, value *Kind) *childKinds {
+	if a == nil {
+		return newChildKinds(key, value)
+	}
+
+	if len(a.children) == 0 {
+		a.children = make([]childKinds, a.size)
+	}
+
+	c := &a.children[0]
+	a.children = a.children[1:]
+	c.key, c.value = key, value
+	c.once.Do(func() {})
+
+	return c
+}
+
+// newFields returns the list for the Kinds of n struct fields. Lists longer
+// than a slab are allocated separately.
+func (a *deepArena) newFields(n int) []*Kind {
+	if a == nil || n > a.size {
+		return make([]*Kind, n)
+	}
+
+	if len(a.fields) < n {
+		a.fields = make([]*Kind, a.size)
+	}
+
+	f := a.fields[:n:n]
+	a.fields = a.fields[n:]
+
+	return f
+}
+
+// fieldKind returns the Kind of the field with the index sequence built
+// by DeepOf, or nil if the fields were not built.
+func (k *Kind) fieldKind(index []int) *Kind {
+	for _, i := range index {
+		if k == nil || k.children == nil || i >= len(k.children.fields) {
+			return nil
+		}
+
+		k = k.children.fields[i]
+	}
+
+	return k
+}
+
+// isEmptyInterface returns true if the type is an empty interface
+// or a pointer, slice or array of them.
+func isEmptyInterface(t reflect.Type) bool {
+	for {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array:
+			t = t.Elem()
+		case reflect.Interface:
+			return t.NumMethod() == 0
+		default:
+			return false
+		}
+	}
+}
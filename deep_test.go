@@ -0,0 +1,352 @@
+package kind
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestDeepOf tests the DeepOf function.
+func TestDeepOf(t *testing.T) {
+	type Address struct {
+		City string
+		Zip  int
+	}
+
+	type User struct {
+		Name    string
+		Address Address
+		Tags    map[string][]Address
+	}
+
+	type Tree struct {
+		Value    int
+		Children map[string]Tree
+	}
+
+	tests := []struct {
+		name      string
+		input     interface{}
+		opts      []DeepOption
+		truncated bool
+	}{
+		{"scalar", 1, nil, false},
+		{"nil", nil, nil, false},
+		{"struct", User{}, nil, false},
+		{"depth", User{}, []DeepOption{WithMaxDepth(1)}, true},
+		{"enough depth", User{}, []DeepOption{WithMaxDepth(3)}, false},
+		{"nodes", User{}, []DeepOption{WithMaxNodes(9)}, true},
+		{"enough nodes", User{}, []DeepOption{WithMaxNodes(10)}, false},
+		{"recursive", Tree{}, nil, false},
+		{"unlimited depth", User{}, []DeepOption{WithMaxDepth(0)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := DeepOf(tt.input, tt.opts...)
+			if k.Truncated() != tt.truncated {
+				t.Errorf("Expected truncated %v, but got %v",
+					tt.truncated, k.Truncated())
+			}
+
+			if ok, diff := deepEqualKind(k, Of(tt.input)); !ok {
+				t.Errorf("Kind differs from Of:\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestIsRecursive tests the IsRecursive and CyclePath methods.
+func TestIsRecursive(t *testing.T) {
+	type Tree struct {
+		Value    int
+		Children []Tree
+	}
+
+	type Node struct {
+		Next  map[string]*Node
+		Items []int
+	}
+
+	type Forest struct {
+		Trees []Tree
+	}
+
+	tests := []struct {
+		name  string
+		kind  *Kind
+		cycle []string
+	}{
+		{"scalar", DeepOf(1), nil},
+		{"slice", DeepOf(Tree{}), []string{"Children"}},
+		{"back-edge", DeepOf(Tree{}).Fields()[1].Kind, []string{"Children"}},
+		{"map", DeepOf(Node{}), []string{"Next", "value"}},
+		{"map field", DeepOf(Node{}).Fields()[0].Kind,
+			[]string{"value", "Next"}},
+		{"not on cycle", DeepOf(Node{}).Fields()[1].Kind, nil},
+		{"contains", DeepOf(Forest{}), nil},
+		{"contained", DeepOf(Forest{}).Fields()[0].Kind, []string{"Children"}},
+		{"shallow", Of(Tree{}), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if r := tt.kind.IsRecursive(); r != (tt.cycle != nil) {
+				t.Errorf("Expected recursive %v, but got %v",
+					tt.cycle != nil, r)
+			}
+
+			if c := tt.kind.CyclePath(); !reflect.DeepEqual(c, tt.cycle) {
+				if len(c) != 0 || len(tt.cycle) != 0 {
+					t.Errorf("Expected cycle %v, but got %v", tt.cycle, c)
+				}
+			}
+		})
+	}
+}
+
+// TestDeepOfFields tests the field kinds built by DeepOf.
+func TestDeepOfFields(t *testing.T) {
+	type Base struct {
+		ID map[string]int
+	}
+
+	type User struct {
+		Base
+		Address struct{ City []string }
+	}
+
+	k := DeepOf(User{}, WithMaxDepth(2))
+	if !k.Truncated() {
+		t.Fatalf("Expected truncated kind")
+	}
+
+	f, _ := k.FieldByName("ID")
+	if !f.Kind.IsMap() || !f.Kind.Truncated() {
+		t.Errorf("Expected truncated map field, but got %v", f.Kind)
+	}
+
+	f, _ = k.Field(1)
+	city := f.Kind.Fields()[0]
+	if city.Kind.Name() != "[]string" || city.Kind.Truncated() {
+		t.Errorf("Expected []string field, but got %v", city.Kind)
+	}
+
+	if f.Kind.Truncated() {
+		t.Errorf("Expected complete Address field")
+	}
+
+	if !k.Fields()[0].Kind.Truncated() {
+		t.Errorf("Expected truncated Base field")
+	}
+}
+
+// TestDeepOfChains tests that DeepOf builds the children of maps
+// and channels behind pointers, slices and arrays.
+func TestDeepOfChains(t *testing.T) {
+	type Recursive struct {
+		Name     string
+		Children []map[string]Recursive
+	}
+
+	k := DeepOf([]map[string]Recursive{})
+	if !k.IsRecursive() {
+		t.Errorf("Expected recursive kind")
+	}
+
+	value := k.MapValueKind()
+	if value.Name() != "kind.Recursive" || len(value.Fields()) != 2 {
+		t.Fatalf("Expected the built map value, but got %v", value)
+	}
+
+	children := value.Fields()[1].Kind
+	if !reflect.DeepEqual(children.CyclePath(), []string{"value", "Children"}) {
+		t.Errorf("Expected the cycle [value Children], but got %v",
+			children.CyclePath())
+	}
+
+	if !DeepOf(&map[string]map[string]int{}, WithMaxDepth(1)).Truncated() {
+		t.Errorf("Expected truncated pointer to map")
+	}
+
+	if !DeepOf([2]chan map[int]int{}, WithMaxNodes(2)).Truncated() {
+		t.Errorf("Expected truncated array of channels")
+	}
+
+	if DeepOf([]map[string]int{}, WithMaxDepth(1)).Truncated() {
+		t.Errorf("Expected the map children to fit the depth")
+	}
+}
+
+// TestWalkLimits tests the limits of the Walk function.
+func TestWalkLimits(t *testing.T) {
+	value := map[string][]int{"a": {1, 2}, "b": {3}}
+
+	tests := []struct {
+		name  string
+		opts  []DeepOption
+		nodes int
+		err   error
+	}{
+		{"unlimited", nil, 6, nil},
+		{"depth", []DeepOption{WithMaxDepth(1)}, 3, ErrTruncated},
+		{"enough depth", []DeepOption{WithMaxDepth(2)}, 6, nil},
+		{"nodes", []DeepOption{WithMaxNodes(4)}, 4, ErrTruncated},
+		{"enough nodes", []DeepOption{WithMaxNodes(6)}, 6, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes := 0
+			err := Walk(value, func(string, *Kind) error {
+				nodes++
+				return nil
+			}, tt.opts...)
+
+			if !errors.Is(err, tt.err) || (err == nil) != (tt.err == nil) {
+				t.Errorf("Expected %v, but got %v", tt.err, err)
+			}
+
+			if nodes != tt.nodes {
+				t.Errorf("Expected %d nodes, but got %d", tt.nodes, nodes)
+			}
+		})
+	}
+}
+
+// TestTryDeepOf tests the TryDeepOf function and the StrictShape option.
+func TestTryDeepOf(t *testing.T) {
+	type Event struct {
+		Name    string
+		Payload map[string]interface{}
+		Tags    []any
+		Source  fmt.Stringer
+	}
+
+	type Batch struct {
+		Events []Event
+		Extra  *interface{}
+	}
+
+	tests := []struct {
+		name  string
+		input interface{}
+		opts  []DeepOption
+		holes []string
+		err   error
+	}{
+		{"concrete", map[string][]int{}, []DeepOption{StrictShape()}, nil, nil},
+		{"not strict", Event{}, nil, nil, nil},
+		{"event", Event{}, []DeepOption{StrictShape()},
+			[]string{"Payload.value", "Tags"}, nil},
+		{"nested", Batch{}, []DeepOption{StrictShape()},
+			[]string{"Events.Payload.value", "Events.Tags", "Extra"}, nil},
+		{"unsupported", [][][]int{}, nil, nil, ErrNestedSequence},
+		{"nil", nil, []DeepOption{StrictShape()}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := TryDeepOf(tt.input, tt.opts...)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) || k != nil {
+					t.Errorf("Expected %v, but got %v %v", tt.err, k, err)
+				}
+
+				return
+			}
+
+			if len(tt.holes) == 0 {
+				if err != nil || k == nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+
+				if ok, diff := deepEqualKind(k, Of(tt.input)); !ok {
+					t.Errorf("Kind differs from Of:\n%s", diff)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, ErrInterfaceHole) || k != nil {
+				t.Fatalf("Expected ErrInterfaceHole, but got %v %v", k, err)
+			}
+
+			var paths []string
+			for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+				paths = append(paths, strings.TrimPrefix(e.Error(),
+					ErrInterfaceHole.Error()+": "))
+			}
+
+			if !reflect.DeepEqual(paths, tt.holes) {
+				t.Errorf("Expected holes %q, but got %q", tt.holes, paths)
+			}
+		})
+	}
+}
+
+// arenaOrder is a struct with many nested nodes for the arena tests.
+type arenaOrder struct {
+	ID    int
+	Items []struct {
+		SKU   string
+		Qty   int
+		Price float64
+		Tags  map[string]string
+	}
+	Customer struct {
+		Name    string
+		Emails  []string
+		Address struct{ City, Street, Zip string }
+	}
+	Notes  map[string][]string
+	Parent *arenaOrder
+}
+
+// TestWithArena tests that the WithArena option builds the same trees
+// with fewer allocations.
+func TestWithArena(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		opts  []DeepOption
+	}{
+		{"order", arenaOrder{}, nil},
+		{"truncated", arenaOrder{}, []DeepOption{WithMaxDepth(2)}},
+		{"map", map[string][]arenaOrder{}, nil},
+		{"scalar", 42, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, size := range []int{1, 3, 64} {
+				opts := append([]DeepOption{WithArena(size)}, test.opts...)
+				plain, arena := DeepOf(test.value, test.opts...), DeepOf(test.value, opts...)
+				if !reflect.DeepEqual(plain, arena) {
+					t.Errorf("Expected the same tree with arena of %d", size)
+				}
+			}
+		})
+	}
+
+	plain := testing.AllocsPerRun(10, func() { DeepOf(arenaOrder{}) })
+	arena := testing.AllocsPerRun(10, func() { DeepOf(arenaOrder{}, WithArena(64)) })
+	if arena*2 > plain {
+		t.Errorf("Expected at most half of %.0f allocations, but got %.0f",
+			plain, arena)
+	}
+}
+
+// BenchmarkDeepOf benchmarks DeepOf with and without the arena.
+func BenchmarkDeepOf(b *testing.B) {
+	for _, size := range []int{0, 64} {
+		b.Run(fmt.Sprintf("arena=%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				DeepOf(arenaOrder{}, WithArena(size))
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package kind
+
+import (
+	"errors"
+	"reflect"
+)
+
+// The maxDescriptorDepth limits the nesting of the described children,
+// which is infinite for recursive types like type M map[string]M.
+const maxDescriptorDepth = 32
+
+// Descriptor is the plain data form of a Kind tree, without the stored
+// value. It has only exported fields of basic types with short keys in
+// json, cbor and msgpack tags, so any serialization library can encode
+// it compactly, e.g. into a binary schema registry:
+//
+//	data, err := cbor.Marshal(k.Descriptor())
+//
+// The Flags field holds the type flags of the Kind as a bit set. The Type
+// field holds the type name qualified by the full package path, which is
+// used to restore the type information when the type is known to Parse.
+type Descriptor struct {
+	Name  string      `json:"n" cbor:"n" msgpack:"n"`
+	Type  string      `json:"t,omitempty" cbor:"t,omitempty" msgpack:"t,omitempty"`
+	Flags uint64      `json:"f,omitempty" cbor:"f,omitempty" msgpack:"f,omitempty"`
+	Key   *Descriptor `json:"k,omitempty" cbor:"k,omitempty" msgpack:"k,omitempty"`
+	Value *Descriptor `json:"v,omitempty" cbor:"v,omitempty" msgpack:"v,omitempty"`
+}
+
+// Descriptor returns the Descriptor of the Kind instance. The Key and
+// Value fields hold the child Kinds of maps, channels and iterators.
+//
+// Example usage:
+//
+//	d := kind.Of(map[string]int{}).Descriptor()
+//	fmt.Println(d.Name, d.Key.Name, d.Value.Name) // "map[string]int string int"
+func (k *Kind) Descriptor() *Descriptor {
+	return newDescriptor(k, 0)
+}
+
+// FromDescriptor returns the Kind instance described by the Descriptor.
+// If the type can be resolved by Parse, the Kind is rebuilt from the
+// type, otherwise it has only the described name, flags and children,
+// and no type information. A type that cannot be built, such as an array
+// too large for the address space, is not resolved either, so untrusted
+// descriptors never make it panic. It returns nil for a nil Descriptor.
+//
+// Example usage:
+//
+//	var d kind.Descriptor
+//	cbor.Unmarshal(data, &d)
+//	k := kind.FromDescriptor(&d)
+func FromDescriptor(d *Descriptor) *Kind {
+	k, _ := fromDescriptor(d)
+	return k
+}
+
+// fromDescriptor works like FromDescriptor, but also returns the errors
+// of the type names that exceed the limits of the parser, which only
+// come from corrupt or crafted descriptors, see errTypeLimit.
+func fromDescriptor(d *Descriptor) (*Kind, error) {
+	if d == nil {
+		return nil, nil
+	}
+
+	var err error
+	if d.Type != "" {
+		var t reflect.Type
+		t, err = parseTypeName(d.Type)
+		if err == nil && t.String() == d.Name {
+			return ofType(t), nil
+		}
+
+		if !errors.Is(err, errTypeLimit) {
+			err = nil
+		}
+	}
+
+	k := &Kind{typeNode: &typeNode{name: intern(d.Name)}}
+	for i, p := range k.flagPtrs() {
+		*p = d.Flags&(1<<i) != 0
+	}
+
+	if d.Key != nil || d.Value != nil {
+		key, kerr := fromDescriptor(d.Key)
+		value, verr := fromDescriptor(d.Value)
+		k.children = newChildKinds(key, value)
+		err = errors.Join(err, kerr, verr)
+	}
+
+	return k, err
+}
+
+// newDescriptor returns the Descriptor of the Kind instance
+// nested at the given depth.
+func newDescriptor(k *Kind, depth int) *Descriptor {
+	d := &Descriptor{Name: k.name}
+	if k.rtype != nil {
+		d.Type = canonicalName(k.rtype)
+	}
+
+	for i, f := range k.flags() {
+		if f {
+			d.Flags |= 1 << i
+		}
+	}
+
+	if k.children != nil && depth < maxDescriptorDepth {
+		c := k.children.get()
+		if c.key != nil {
+			d.Key = newDescriptor(c.key, depth+1)
+		}
+
+		if c.value != nil {
+			d.Value = newDescriptor(c.value, depth+1)
+		}
+	}
+
+	return d
+}
@@ -0,0 +1,61 @@
+package kind
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestDescriptor tests the Kind.Descriptor method and
+// the FromDescriptor function.
+func TestDescriptor(t *testing.T) {
+	type local struct{ A int }
+
+	tests := []struct {
+		name    string
+		kind    *Kind
+		encoded string
+	}{
+		{"int", Of(1), `{"n":"int","t":"int","f":67108864}`},
+		{"nil", Of(nil), `{"n":"nil","f":2}`},
+		{
+			"map",
+			Of(map[string]bool{}),
+			`{"n":"map[string]bool","t":"map[string]bool","f":512,` +
+				`"k":{"n":"string","t":"string","f":131072},` +
+				`"v":{"n":"bool","t":"bool","f":65536}}`,
+		},
+		{
+			"unresolved",
+			Of(map[int]local{}),
+			`{"n":"map[int]kind.local","t":"map[int]github.com/goloop/kind.local","f":512,` +
+				`"k":{"n":"int","t":"int","f":67108864},` +
+				`"v":{"n":"kind.local","t":"github.com/goloop/kind.local","f":1024}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.kind.Descriptor())
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if string(data) != tt.encoded {
+				t.Errorf("Expected %s, but got %s", tt.encoded, data)
+			}
+
+			var d Descriptor
+			if err := json.Unmarshal(data, &d); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if ok, diff := deepEqualKind(FromDescriptor(&d), tt.kind); !ok {
+				t.Errorf("Decoded kind differs:\n%s", diff)
+			}
+		})
+	}
+
+	if FromDescriptor(nil) != nil {
+		t.Errorf("Expected nil for nil descriptor")
+	}
+}
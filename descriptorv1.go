@@ -0,0 +1,121 @@
+package kind
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// DescriptorVersion is the version of the descriptor format
+// written by WriteDescriptor.
+const DescriptorVersion = 1
+
+// ErrInvalidDescriptor is returned by ReadDescriptor when the data is
+// not a valid descriptor or has a version newer than DescriptorVersion.
+var ErrInvalidDescriptor = errors.New("kind: invalid descriptor")
+
+// The descriptorV1 is the version 1 of the descriptor format. Unlike
+// Descriptor, it stores the flags by name, so it does not depend on the
+// internal order of the flags. The names are the ones printed by the %v
+// verb, see Kind.Format, and are never changed; readers ignore unknown
+// names, so new flags can be added without a new version.
+type descriptorV1 struct {
+	Version int           `json:"version,omitempty"`
+	Name    string        `json:"name"`
+	Type    string        `json:"type,omitempty"`
+	Flags   []string      `json:"flags,omitempty"`
+	Key     *descriptorV1 `json:"key,omitempty"`
+	Value   *descriptorV1 `json:"value,omitempty"`
+}
+
+// WriteDescriptor writes the descriptor of the Kind instance to w in the
+// canonical format of the current DescriptorVersion: a JSON object with
+// the version, the name, the full type name, the names of the set flags
+// and the child Kinds, followed by a newline. The format of a version
+// never changes, and ReadDescriptor of later releases reads all earlier
+// versions, so descriptors can be stored for a long time.
+//
+// Example usage:
+//
+//	kind.WriteDescriptor(os.Stdout, kind.Of(map[string]bool{}))
+//	// {"version":1,"name":"map[string]bool","type":"map[string]bool",
+//	// "flags":["map"],"key":{...},"value":{...}}
+func WriteDescriptor(w io.Writer, k *Kind) error {
+	d := newDescriptorV1(k.Descriptor())
+	d.Version = DescriptorVersion
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// ReadDescriptor reads a descriptor written by WriteDescriptor of this
+// or an earlier release and returns the Kind instance it describes,
+// built by FromDescriptor. It can read past the end of the descriptor,
+// so r should hold a single descriptor, e.g. a file.
+//
+// Example usage:
+//
+//	f, _ := os.Open("user.kind")
+//	defer f.Close()
+//
+//	k, err := kind.ReadDescriptor(f)
+func ReadDescriptor(r io.Reader) (*Kind, error) {
+	var d descriptorV1
+	if err := json.NewDecoder(r).Decode(&d); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDescriptor, err)
+	}
+
+	switch d.Version {
+	case 1:
+		return FromDescriptor(d.descriptor()), nil
+	case 0:
+		return nil, fmt.Errorf("%w: missing version", ErrInvalidDescriptor)
+	}
+
+	return nil, fmt.Errorf("%w: unsupported version %d",
+		ErrInvalidDescriptor, d.Version)
+}
+
+// newDescriptorV1 returns the version 1 form of the Descriptor.
+func newDescriptorV1(d *Descriptor) *descriptorV1 {
+	if d == nil {
+		return nil
+	}
+
+	v1 := &descriptorV1{Name: d.Name, Type: d.Type}
+	for i, name := range flagNames {
+		if d.Flags&(1<<i) != 0 {
+			v1.Flags = append(v1.Flags, name)
+		}
+	}
+
+	v1.Key = newDescriptorV1(d.Key)
+	v1.Value = newDescriptorV1(d.Value)
+	return v1
+}
+
+// descriptor returns the Descriptor of the version 1 form.
+func (v1 *descriptorV1) descriptor() *Descriptor {
+	if v1 == nil {
+		return nil
+	}
+
+	d := &Descriptor{Name: v1.Name, Type: v1.Type}
+	for _, flag := range v1.Flags {
+		for i, name := range flagNames {
+			if name == flag {
+				d.Flags |= 1 << i
+			}
+		}
+	}
+
+	d.Key = v1.Key.descriptor()
+	d.Value = v1.Value.descriptor()
+	return d
+}
@@ -0,0 +1,106 @@
+package kind
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestWriteDescriptor tests the WriteDescriptor and
+// ReadDescriptor functions.
+func TestWriteDescriptor(t *testing.T) {
+	type local struct{}
+
+	tests := []struct {
+		name    string
+		kind    *Kind
+		written string
+	}{
+		{
+			name:    "int",
+			kind:    Of(1),
+			written: `{"version":1,"name":"int","type":"int","flags":["int"]}`,
+		},
+		{
+			name: "map",
+			kind: Of(map[string][]bool{}),
+			written: `{"version":1,"name":"map[string][]bool",` +
+				`"type":"map[string][]bool","flags":["map"],` +
+				`"key":{"name":"string","type":"string","flags":["string"]},` +
+				`"value":{"name":"[]bool","type":"[]bool","flags":["slice","bool"]}}`,
+		},
+		{
+			name: "unresolved",
+			kind: Of([]local{}),
+			written: `{"version":1,"name":"[]kind.local",` +
+				`"type":"[]github.com/goloop/kind.local","flags":["slice","struct"]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteDescriptor(&buf, tt.kind); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if buf.String() != tt.written+"\n" {
+				t.Errorf("Expected %s, but got %s", tt.written, buf.String())
+			}
+
+			k, err := ReadDescriptor(&buf)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if ok, diff := deepEqualKind(k, tt.kind); !ok {
+				t.Errorf("Decoded kind differs:\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestReadDescriptor tests reading of stored and invalid descriptors.
+func TestReadDescriptor(t *testing.T) {
+	// Unknown flags, e.g. written by a later release, are ignored.
+	k, err := ReadDescriptor(strings.NewReader(
+		`{"version":1,"name":"T","flags":["struct","future"]}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if k.Name() != "T" || !k.IsStruct() {
+		t.Errorf("Unexpected kind %v", k)
+	}
+
+	// Types that cannot be built are not resolved.
+	for _, typ := range []string{
+		"[9223372036854775807][9223372036854775807]int64",
+		"chan [65536]byte",
+		strings.Repeat("*", 100000) + "int",
+	} {
+		data := `{"version":1,"name":"T","type":"` + typ + `","flags":["array"]}`
+		k, err := ReadDescriptor(strings.NewReader(data))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if k.Name() != "T" || !k.IsArray() || k.rtype != nil {
+			t.Errorf("Expected unresolved kind T, but got %v", k)
+		}
+	}
+
+	invalid := []string{
+		``,
+		`[]`,
+		`{"name":"int"}`,
+		`{"version":2,"name":"int"}`,
+	}
+
+	for _, data := range invalid {
+		if _, err := ReadDescriptor(strings.NewReader(data)); !errors.Is(err, ErrInvalidDescriptor) {
+			t.Errorf("Expected ErrInvalidDescriptor for %q, but got %v", data, err)
+		}
+	}
+}
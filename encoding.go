@@ -0,0 +1,77 @@
+package kind
+
+import (
+	"encoding"
+	"reflect"
+)
+
+// The gobEncoder and gobDecoder mirror the gob.GobEncoder and
+// gob.GobDecoder interfaces, so the encoding/gob package with its
+// type registry is not linked into every binary using this package.
+type (
+	gobEncoder interface {
+		GobEncode() ([]byte, error)
+	}
+
+	gobDecoder interface {
+		GobDecode([]byte) error
+	}
+)
+
+var (
+	binaryMarshalerType   = reflect.TypeOf((*encoding.BinaryMarshaler)(nil)).Elem()
+	binaryUnmarshalerType = reflect.TypeOf((*encoding.BinaryUnmarshaler)(nil)).Elem()
+	gobEncoderType        = reflect.TypeOf((*gobEncoder)(nil)).Elem()
+	gobDecoderType        = reflect.TypeOf((*gobDecoder)(nil)).Elem()
+)
+
+// IsBinaryMarshaler returns true if the type represented by the Kind
+// instance implements encoding.BinaryMarshaler.
+func (k *Kind) IsBinaryMarshaler() bool {
+	return k.rtype != nil && k.rtype.Implements(binaryMarshalerType)
+}
+
+// IsBinaryUnmarshaler returns true if a pointer to the type represented
+// by the Kind instance implements encoding.BinaryUnmarshaler. Decoding
+// always needs a pointer, so the pointer method set is checked.
+func (k *Kind) IsBinaryUnmarshaler() bool {
+	return implementsByPointer(k.rtype, binaryUnmarshalerType)
+}
+
+// IsGobEncoder returns true if the type represented by the Kind
+// instance implements gob.GobEncoder.
+func (k *Kind) IsGobEncoder() bool {
+	return k.rtype != nil && k.rtype.Implements(gobEncoderType)
+}
+
+// IsGobDecoder returns true if a pointer to the type represented by
+// the Kind instance implements gob.GobDecoder. Decoding always needs
+// a pointer, so the pointer method set is checked.
+func (k *Kind) IsGobDecoder() bool {
+	return implementsByPointer(k.rtype, gobDecoderType)
+}
+
+// SupportsBinaryEncoding returns true if values of the type represented
+// by the Kind instance can be encoded to and decoded from a binary form
+// by their own methods, that is the type implements the pair of
+// encoding.BinaryMarshaler and encoding.BinaryUnmarshaler or the pair
+// of gob.GobEncoder and gob.GobDecoder.
+//
+// Example usage:
+//
+//	k := kind.Of(time.Now())
+//	fmt.Println(k.SupportsBinaryEncoding()) // true
+func (k *Kind) SupportsBinaryEncoding() bool {
+	return (k.IsBinaryMarshaler() && k.IsBinaryUnmarshaler()) ||
+		(k.IsGobEncoder() && k.IsGobDecoder())
+}
+
+// implementsByPointer returns true if the type or a pointer
+// to it implements the interface.
+func implementsByPointer(t, iface reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	return t.Implements(iface) || reflect.PtrTo(t).Implements(iface)
+}
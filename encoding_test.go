@@ -0,0 +1,67 @@
+package kind
+
+import (
+	"encoding/gob"
+	"testing"
+	"time"
+)
+
+// gobOnly implements only the gob encoding interfaces.
+type gobOnly struct{}
+
+// GobEncode encodes the value.
+func (gobOnly) GobEncode() ([]byte, error) { return nil, nil }
+
+// GobDecode decodes the value.
+func (*gobOnly) GobDecode([]byte) error { return nil }
+
+// marshalOnly implements only encoding.BinaryMarshaler.
+type marshalOnly struct{}
+
+// MarshalBinary encodes the value.
+func (marshalOnly) MarshalBinary() ([]byte, error) { return nil, nil }
+
+// Ensure the local interfaces match the gob ones.
+var (
+	_ gob.GobEncoder = gobOnly{}
+	_ gob.GobDecoder = &gobOnly{}
+	_ gobEncoder     = gob.GobEncoder(nil)
+	_ gobDecoder     = gob.GobDecoder(nil)
+)
+
+// TestBinaryEncoding tests the binary encoding detection methods.
+func TestBinaryEncoding(t *testing.T) {
+	tests := []struct {
+		name                                 string
+		input                                interface{}
+		marshaler, unmarshaler, enc, dec, ok bool
+	}{
+		{"time", time.Time{}, true, true, true, true, true},
+		{"time pointer", &time.Time{}, true, true, true, true, true},
+		{"gob", gobOnly{}, false, false, true, true, true},
+		{"marshal only", marshalOnly{}, true, false, false, false, false},
+		{"int", 1, false, false, false, false, false},
+		{"nil", nil, false, false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := Of(tt.input)
+			result := []bool{
+				k.IsBinaryMarshaler(), k.IsBinaryUnmarshaler(),
+				k.IsGobEncoder(), k.IsGobDecoder(),
+				k.SupportsBinaryEncoding(),
+			}
+			expected := []bool{
+				tt.marshaler, tt.unmarshaler, tt.enc, tt.dec, tt.ok,
+			}
+
+			for i := range result {
+				if result[i] != expected[i] {
+					t.Errorf("Expected %v, but got %v", expected, result)
+					break
+				}
+			}
+		})
+	}
+}
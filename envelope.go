@@ -0,0 +1,138 @@
+package kind
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrInvalidEnvelope is returned by Decode when the data
+// is not a valid envelope.
+var ErrInvalidEnvelope = errors.New("kind: invalid envelope")
+
+// The maxEnvelopeTypeSize limits the size in bytes of the types decoded
+// by Decode and of the types they hold, so that a crafted kind, such as
+// [1 << 40]byte, cannot make it allocate huge values.
+const maxEnvelopeTypeSize = 1 << 20
+
+// The envelope is the encoded form of a value together with its type.
+type envelope struct {
+	Kind  string          `json:"kind"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Encode returns the self-describing encoding of the value: a JSON
+// envelope holding the type of the value and the value itself, which
+// Decode turns back into a value of the same concrete type.
+//
+// The type must be one that Parse can resolve, so named types must be
+// registered by Register before encoding and decoding. Values nested in
+// interfaces, such as the elements of []interface{}, are decoded as
+// encoding/json decodes them into interface{}.
+//
+// Example usage:
+//
+//	kind.Register(User{})
+//
+//	data, _ := kind.Encode(User{Name: "Bob"})
+//	fmt.Println(string(data))
+//	// {"kind":"main.User","value":{"Name":"Bob"}}
+//
+//	v, k, _ := kind.Decode(data)
+//	fmt.Println(v.(User).Name, k.Name()) // "Bob" "main.User"
+func Encode(v interface{}) ([]byte, error) {
+	env := envelope{Kind: "nil", Value: json.RawMessage("null")}
+	if v != nil {
+		t := reflect.TypeOf(v)
+		env.Kind = canonicalName(t)
+		if pt, err := parseTypeName(env.Kind); err != nil || pt != t {
+			return nil, fmt.Errorf("%w: type %s cannot be resolved by name",
+				ErrInvalidTypeName, t)
+		}
+
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+
+		env.Value = value
+	}
+
+	return json.Marshal(env)
+}
+
+// Decode decodes the envelope produced by Encode and returns the value
+// with its original concrete type, and the Kind instance of the value.
+// It returns ErrInvalidEnvelope if the type, or any type it holds, such
+// as the element of a slice, is larger than 1 MiB.
+//
+// Example usage:
+//
+//	v, k, err := kind.Decode([]byte(`{"kind":"[]int","value":[1,2]}`))
+//	fmt.Println(v, k.Name(), err) // [1 2] []int <nil>
+func Decode(data []byte) (interface{}, *Kind, error) {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidEnvelope, err)
+	}
+
+	switch env.Kind {
+	case "":
+		return nil, nil, fmt.Errorf("%w: missing kind", ErrInvalidEnvelope)
+	case "nil":
+		return nil, Of(nil), nil
+	}
+
+	t, err := parseTypeName(env.Kind)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if big := largeType(t, map[reflect.Type]bool{}); big != nil {
+		return nil, nil, fmt.Errorf("%w: type %s is too large",
+			ErrInvalidEnvelope, big)
+	}
+
+	ptr := reflect.New(t)
+	if len(env.Value) != 0 {
+		if err := json.Unmarshal(env.Value, ptr.Interface()); err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidEnvelope, err)
+		}
+	}
+
+	v := ptr.Elem().Interface()
+	return v, Of(v), nil
+}
+
+// largeType returns the type, or the first type it holds, whose
+// size exceeds maxEnvelopeTypeSize, or nil if there is none.
+func largeType(t reflect.Type, seen map[reflect.Type]bool) reflect.Type {
+	if seen[t] {
+		return nil
+	}
+
+	seen[t] = true
+	if t.Size() > maxEnvelopeTypeSize {
+		return t
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Chan:
+		return largeType(t.Elem(), seen)
+	case reflect.Map:
+		if big := largeType(t.Key(), seen); big != nil {
+			return big
+		}
+
+		return largeType(t.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if big := largeType(t.Field(i).Type, seen); big != nil {
+				return big
+			}
+		}
+	}
+
+	return nil
+}
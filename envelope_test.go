@@ -0,0 +1,96 @@
+package kind
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestEncode tests the Encode and Decode functions.
+func TestEncode(t *testing.T) {
+	type Item struct {
+		Name  string
+		Price float64
+		Tags  []string
+	}
+
+	Register(Item{})
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		encoded string
+	}{
+		{"int", 42, `{"kind":"int","value":42}`},
+		{"uint8", uint8(7), `{"kind":"uint8","value":7}`},
+		{"nil", nil, `{"kind":"nil","value":null}`},
+		{"map", map[string][]int{"a": {1}}, `{"kind":"map[string][]int","value":{"a":[1]}}`},
+		{
+			"struct",
+			Item{Name: "pen", Price: 1.5},
+			`{"kind":"github.com/goloop/kind.Item","value":{"Name":"pen","Price":1.5,"Tags":null}}`,
+		},
+		{
+			"pointer to struct",
+			&Item{Name: "pen", Tags: []string{"a"}},
+			`{"kind":"*github.com/goloop/kind.Item","value":{"Name":"pen","Price":0,"Tags":["a"]}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Encode(tt.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if string(data) != tt.encoded {
+				t.Errorf("Expected %s, but got %s", tt.encoded, data)
+			}
+
+			v, k, err := Decode(data)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(v, tt.input) {
+				t.Errorf("Expected %#v, but got %#v", tt.input, v)
+			}
+
+			if k.Name() != Of(tt.input).Name() {
+				t.Errorf("Expected kind %s, but got %s",
+					Of(tt.input).Name(), k.Name())
+			}
+		})
+	}
+}
+
+// TestEncodeErrors tests the errors of the Encode and Decode functions.
+func TestEncodeErrors(t *testing.T) {
+	type unregistered struct{}
+
+	if _, err := Encode(unregistered{}); !errors.Is(err, ErrInvalidTypeName) {
+		t.Errorf("Expected ErrInvalidTypeName, but got %v", err)
+	}
+
+	if _, err := Encode(struct{ A int }{}); !errors.Is(err, ErrInvalidTypeName) {
+		t.Errorf("Expected ErrInvalidTypeName, but got %v", err)
+	}
+
+	invalid := map[string]error{
+		`[]`:                             ErrInvalidEnvelope,
+		`{"value":1}`:                    ErrInvalidEnvelope,
+		`{"kind":"int","value":"x"}`:     ErrInvalidEnvelope,
+		`{"kind":"Unknown","value":{}}`:  ErrInvalidTypeName,
+		`{"kind":"[1099511627776]byte"}`: ErrInvalidEnvelope,
+		`{"kind":"[][1048577]byte"}`:     ErrInvalidEnvelope,
+		`{"kind":"map[string]*[2097152]bool","value":{}}`:            ErrInvalidEnvelope,
+		`{"kind":"[9223372036854775807][9223372036854775807]int64"}`: ErrInvalidTypeName,
+	}
+
+	for data, expected := range invalid {
+		if _, _, err := Decode([]byte(data)); !errors.Is(err, expected) {
+			t.Errorf("Expected %v for %s, but got %v", expected, data, err)
+		}
+	}
+}
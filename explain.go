@@ -0,0 +1,113 @@
+package kind
+
+import "strconv"
+
+// Difference describes a difference between two Kind instances.
+type Difference struct {
+	Path     string // path of the child Kind, empty for the root, e.g. "value.key"
+	Field    string // "kind", "name" or the name of a type flag, e.g. "slice"
+	Expected string // value in the expected Kind
+	Actual   string // value in the actual Kind
+}
+
+// Explain returns the differences between the Kind instance, which is
+// the expected one, and the other, actual, Kind instance: of their names,
+// of their type flags and of their child Kinds, such as the kinds of map
+// keys and values. It returns nil if the Kinds are equal. The stored
+// values are not compared.
+//
+// Example usage:
+//
+//	diffs := kind.Of(map[string]int{}).Explain(kind.Of(map[string]int8{}))
+//	for _, d := range diffs {
+//		fmt.Printf("%q %s: %s != %s\n", d.Path, d.Field, d.Expected, d.Actual)
+//	}
+//	// "" name: map[string]int != map[string]int8
+//	// "value" name: int != int8
+//	// ...
+func (k *Kind) Explain(other *Kind) []Difference {
+	var diffs []Difference
+	explain(k, other, "", 0, &diffs)
+	return diffs
+}
+
+// Equal returns true if the Kind instance and the other
+// Kind instance are equal, see Explain.
+func (k *Kind) Equal(other *Kind) bool {
+	return len(k.Explain(other)) == 0
+}
+
+// explain appends the differences between
+// the expected and actual Kinds to diffs.
+func explain(expected, actual *Kind, path string, depth int, diffs *[]Difference) {
+	if expected == nil || actual == nil {
+		if expected != actual {
+			*diffs = append(*diffs, Difference{
+				Path:     path,
+				Field:    "kind",
+				Expected: presence(expected != nil),
+				Actual:   presence(actual != nil),
+			})
+		}
+
+		return
+	}
+
+	if expected.name != actual.name {
+		*diffs = append(*diffs, Difference{
+			Path:     path,
+			Field:    "name",
+			Expected: expected.name,
+			Actual:   actual.name,
+		})
+	}
+
+	af := actual.flags()
+	for i, f := range expected.flags() {
+		if f != af[i] {
+			*diffs = append(*diffs, Difference{
+				Path:     path,
+				Field:    flagNames[i],
+				Expected: strconv.FormatBool(f),
+				Actual:   strconv.FormatBool(af[i]),
+			})
+		}
+	}
+
+	if depth >= maxDescriptorDepth {
+		return
+	}
+
+	ek, ev := expected.childKinds()
+	ak, av := actual.childKinds()
+	explain(ek, ak, childPath(path, "key"), depth+1, diffs)
+	explain(ev, av, childPath(path, "value"), depth+1, diffs)
+}
+
+// childKinds returns the key and value child Kinds, or nil if absent.
+func (k *Kind) childKinds() (*Kind, *Kind) {
+	if k.children == nil {
+		return nil, nil
+	}
+
+	c := k.children.get()
+	return c.key, c.value
+}
+
+// childPath returns the path of the child Kind.
+func childPath(path, child string) string {
+	if path == "" {
+		return child
+	}
+
+	return path + "." + child
+}
+
+// presence returns the description of the Kind presence.
+func presence(present bool) string {
+	if present {
+		return "present"
+	}
+
+	return "absent"
+}
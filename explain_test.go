@@ -0,0 +1,71 @@
+package kind
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestExplain tests the Kind.Explain and Kind.Equal methods.
+func TestExplain(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected *Kind
+		actual   *Kind
+		diffs    []Difference
+	}{
+		{"equal", Of(1), Of(2), nil},
+		{"equal maps", Of(map[string]int{}), For[map[string]int](), nil},
+		{
+			name:     "scalar",
+			expected: Of(1),
+			actual:   Of(int8(1)),
+			diffs: []Difference{
+				{Field: "name", Expected: "int", Actual: "int8"},
+				{Field: "int8", Expected: "false", Actual: "true"},
+				{Field: "int", Expected: "true", Actual: "false"},
+			},
+		},
+		{
+			name:     "map value",
+			expected: Of(map[string][]int{}),
+			actual:   Of(map[string]int{}),
+			diffs: []Difference{
+				{Field: "name", Expected: "map[string][]int", Actual: "map[string]int"},
+				{Path: "value", Field: "name", Expected: "[]int", Actual: "int"},
+				{Path: "value", Field: "slice", Expected: "true", Actual: "false"},
+			},
+		},
+		{
+			name:     "missing children",
+			expected: Of(make(chan bool)),
+			actual:   Of(true),
+			diffs: []Difference{
+				{Field: "name", Expected: "chan bool", Actual: "bool"},
+				{Field: "channel", Expected: "true", Actual: "false"},
+				{Field: "bool", Expected: "false", Actual: "true"},
+				{Path: "value", Field: "kind", Expected: "present", Actual: "absent"},
+			},
+		},
+		{
+			name:     "nil",
+			expected: Of(1),
+			actual:   nil,
+			diffs: []Difference{
+				{Field: "kind", Expected: "present", Actual: "absent"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diffs := tt.expected.Explain(tt.actual)
+			if !reflect.DeepEqual(diffs, tt.diffs) {
+				t.Errorf("Expected %+v, but got %+v", tt.diffs, diffs)
+			}
+
+			if equal := tt.expected.Equal(tt.actual); equal != (tt.diffs == nil) {
+				t.Errorf("Expected equal %v, but got %v", tt.diffs == nil, equal)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package kind
+
+import "reflect"
+
+// Field describes a field of a struct type.
+type Field struct {
+	Name     string            // name of the field
+	Tag      reflect.StructTag // tag of the field
+	Kind     *Kind             // kind of the field type
+	Index    []int             // index sequence for reflect's FieldByIndex
+	Offset   uintptr           // offset in the struct that declares the field
+	Exported bool              // field is exported
+	Embedded bool              // field is an embedded field
+}
+
+// Fields returns the fields of the struct represented by the Kind
+// instance in declaration order, so the last element of the Index of
+// each field is its position. For a pointer, slice or array of
+// structs, the fields of the element struct are returned.
+// It returns nil if the Kind instance does not represent a struct.
+//
+// Example usage:
+//
+//	type User struct {
+//		Name string `json:"name"`
+//		Age  int    `json:"age"`
+//	}
+//
+//	for _, f := range kind.Of(User{}).Fields() {
+//		fmt.Println(f.Name, f.Kind.Name()) // "Name string", "Age int"
+//	}
+func (k *Kind) Fields() []Field {
+	t := k.structType()
+	if t == nil {
+		return nil
+	}
+
+	fields := make([]Field, t.NumField())
+	for i := range fields {
+		fields[i] = k.newField(t.Field(i))
+	}
+
+	return fields
+}
+
+// NumField returns the number of fields of the struct represented by
+// the Kind instance, or 0 if the Kind instance does not represent
+// a struct. Like reflect, it counts embedded fields as single fields.
+func (k *Kind) NumField() int {
+	t := k.structType()
+	if t == nil {
+		return 0
+	}
+
+	return t.NumField()
+}
+
+// Field returns the i'th field of the struct represented by the Kind
+// instance in declaration order. It returns false if the Kind instance
+// does not represent a struct or i is out of range.
+//
+// Example usage:
+//
+//	k := kind.Of(User{})
+//	for i := 0; i < k.NumField(); i++ {
+//		f, _ := k.Field(i)
+//		fmt.Println(f.Name, f.Index) // "Name [0]", "Age [1]"
+//	}
+func (k *Kind) Field(i int) (Field, bool) {
+	t := k.structType()
+	if t == nil || i < 0 || i >= t.NumField() {
+		return Field{}, false
+	}
+
+	return k.newField(t.Field(i)), true
+}
+
+// FieldByName returns the field with the given name, including the
+// fields promoted from embedded structs, which have index paths longer
+// than one. It follows the reflect rules for ambiguous names and returns
+// false if there is no such field.
+//
+// Example usage:
+//
+//	type Base struct{ ID int }
+//	type User struct {
+//		Base
+//		Name string
+//	}
+//
+//	f, _ := kind.Of(User{}).FieldByName("ID")
+//	fmt.Println(f.Index) // [0 0]
+func (k *Kind) FieldByName(name string) (Field, bool) {
+	t := k.structType()
+	if t == nil {
+		return Field{}, false
+	}
+
+	sf, ok := t.FieldByName(name)
+	if !ok {
+		return Field{}, false
+	}
+
+	return k.newField(sf), true
+}
+
+// HasUnexportedFields returns true if the struct represented by the Kind
+// instance has unexported fields, either its own or the ones of the
+// structs it contains through fields, pointers, slices, arrays, maps
+// and channels. Utilities that copy, encode or hash values through
+// reflect can check it before they start instead of failing midway.
+// It is computed once, when the type is analyzed.
+func (k *Kind) HasUnexportedFields() bool {
+	return k.hasUnexported
+}
+
+// newField returns the Field for the field of the struct represented by
+// the Kind instance, with the field Kind built by DeepOf if there is one.
+func (k *Kind) newField(sf reflect.StructField) Field {
+	f := newField(sf)
+	if fk := k.fieldKind(sf.Index); fk != nil {
+		f.Kind = fk
+	}
+
+	return f
+}
+
+// newField returns the Field for the struct field.
+func newField(sf reflect.StructField) Field {
+	return Field{
+		Name:     sf.Name,
+		Tag:      sf.Tag,
+		Kind:     ofType(sf.Type),
+		Index:    sf.Index,
+		Offset:   sf.Offset,
+		Exported: sf.IsExported(),
+		Embedded: sf.Anonymous,
+	}
+}
+
+// structType returns the struct type represented by the Kind instance,
+// or nil if the Kind instance does not represent a struct.
+func (k *Kind) structType() reflect.Type {
+	if !k.isStruct || k.rtype == nil {
+		return nil
+	}
+
+	t := k.rtype
+	for t.Kind() != reflect.Struct {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array:
+			t = t.Elem()
+		default:
+			return nil
+		}
+	}
+
+	return t
+}
+
+// hasUnexportedFields returns true if the type has a struct with
+// unexported fields at any depth. The seen types are skipped.
+func hasUnexportedFields(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+
+	seen[t] = true
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Chan:
+		return hasUnexportedFields(t.Elem(), seen)
+	case reflect.Map:
+		return hasUnexportedFields(t.Key(), seen) ||
+			hasUnexportedFields(t.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if !sf.IsExported() || hasUnexportedFields(sf.Type, seen) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
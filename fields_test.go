@@ -0,0 +1,196 @@
+package kind
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestFields tests the Kind.Fields method.
+func TestFields(t *testing.T) {
+	type Base struct {
+		ID int
+	}
+
+	type User struct {
+		Base
+		Name  string `json:"name"`
+		tags  []string
+		Attrs map[string]int
+	}
+
+	tests := []struct {
+		name  string
+		input interface{}
+	}{
+		{"struct", User{}},
+		{"pointer", &User{}},
+		{"slice", []User{}},
+		{"slice of pointers", []*User{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := Of(tt.input).Fields()
+			if len(fields) != 4 {
+				t.Fatalf("Expected 4 fields, but got %d", len(fields))
+			}
+
+			expected := []struct {
+				name     string
+				kind     string
+				exported bool
+				embedded bool
+			}{
+				{"Base", "kind.Base", true, true},
+				{"Name", "string", true, false},
+				{"tags", "[]string", false, false},
+				{"Attrs", "map[string]int", true, false},
+			}
+
+			for i, e := range expected {
+				f := fields[i]
+				if f.Name != e.name || f.Kind.Name() != e.kind ||
+					f.Exported != e.exported || f.Embedded != e.embedded {
+					t.Errorf("Expected field %+v, but got %+v", e, f)
+				}
+			}
+
+			if tag := fields[1].Tag.Get("json"); tag != "name" {
+				t.Errorf("Expected tag name, but got %s", tag)
+			}
+
+			if !fields[3].Kind.IsMap() ||
+				!fields[3].Kind.MapValueKind().IsInt() {
+				t.Errorf("Expected map field kind, but got %s",
+					fields[3].Kind)
+			}
+		})
+	}
+}
+
+// TestFieldsNotStruct tests the Kind.Fields method for non-struct kinds.
+func TestFieldsNotStruct(t *testing.T) {
+	for _, v := range []interface{}{nil, 1, []int{}, map[string]struct{}{}} {
+		if fields := Of(v).Fields(); fields != nil {
+			t.Errorf("Expected nil fields for %T, but got %v", v, fields)
+		}
+	}
+}
+
+// TestFieldIndex tests the Kind.NumField, Kind.Field and
+// Kind.FieldByName methods.
+func TestFieldIndex(t *testing.T) {
+	type Base struct {
+		ID   int
+		Name string
+	}
+
+	type User struct {
+		Base
+		Name  string
+		Email string
+	}
+
+	k := Of(&User{})
+	if n := k.NumField(); n != 3 {
+		t.Fatalf("Expected 3 fields, but got %d", n)
+	}
+
+	rt := reflect.TypeOf(User{})
+	for i := 0; i < k.NumField(); i++ {
+		f, ok := k.Field(i)
+		if !ok {
+			t.Fatalf("Expected field %d", i)
+		}
+
+		sf := rt.Field(i)
+		if f.Name != sf.Name || !reflect.DeepEqual(f.Index, sf.Index) ||
+			f.Offset != sf.Offset {
+			t.Errorf("Expected field %s %v at %d, but got %s %v at %d",
+				sf.Name, sf.Index, sf.Offset, f.Name, f.Index, f.Offset)
+		}
+	}
+
+	for _, i := range []int{-1, 3} {
+		if _, ok := k.Field(i); ok {
+			t.Errorf("Expected no field %d", i)
+		}
+	}
+
+	tests := map[string][]int{"ID": {0, 0}, "Name": {1}, "Email": {2}}
+	for name, index := range tests {
+		f, ok := k.FieldByName(name)
+		if !ok || !reflect.DeepEqual(f.Index, index) {
+			t.Errorf("Expected field %s %v, but got %v", name, index, f.Index)
+		}
+
+		if sf, _ := rt.FieldByName(name); f.Offset != sf.Offset {
+			t.Errorf("Expected field %s at %d, but got %d",
+				name, sf.Offset, f.Offset)
+		}
+	}
+
+	if _, ok := k.FieldByName("Missing"); ok {
+		t.Error("Expected no field Missing")
+	}
+
+	if k := Of(1); k.NumField() != 0 {
+		t.Errorf("Expected 0 fields, but got %d", k.NumField())
+	} else if _, ok := k.Field(0); ok {
+		t.Error("Expected no field for int")
+	} else if _, ok := k.FieldByName("ID"); ok {
+		t.Error("Expected no field for int")
+	}
+}
+
+// TestHasUnexportedFields tests the Kind.HasUnexportedFields method.
+func TestHasUnexportedFields(t *testing.T) {
+	type base struct{ ID int }
+
+	type Public struct {
+		Name string
+		Tags []string
+	}
+
+	type Private struct {
+		Name string
+		age  int
+	}
+
+	type Nested struct {
+		Users map[string][]*Private
+	}
+
+	type Embedded struct {
+		base
+	}
+
+	type Node struct {
+		Next *Node
+		Data Public
+	}
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected bool
+	}{
+		{"exported", Public{}, false},
+		{"unexported", Private{}, true},
+		{"nested", Nested{}, true},
+		{"embedded", Embedded{}, true},
+		{"recursive", Node{}, false},
+		{"pointer", &Private{}, true},
+		{"slice", []Public{}, false},
+		{"not struct", 1, false},
+		{"nil", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if r := Of(tt.input).HasUnexportedFields(); r != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package kind
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The flagNames holds the names of the type flags in the order of
+// typeNode.flagPtrs. The names are part of the descriptor format,
+// see WriteDescriptor, so they must never be changed.
+var flagNames = []string{
+	"undefined", "nil", "pointer", "array", "slice",
+	"sliceOfSlices", "arrayOfSlices", "sliceOfArrays",
+	"arrayOfArrays", "map", "struct", "interface",
+	"function", "channel", "seq", "seq2", "bool",
+	"string", "int8", "int16", "int32", "int64",
+	"uint8", "uint16", "uint32", "uint64",
+	"int", "uint", "uintptr", "unsafePointer",
+	"float32", "float64",
+	"complex64", "complex128",
+}
+
+// Format implements fmt.Formatter. It supports the verbs:
+//
+//	%s, %q  the name, as String returns it
+//	%v      the name with the set type flags, e.g. "[]*int (pointer|slice|int)"
+//	%+v     the full tree: the name and flags with the child Kinds
+//	        of maps, channels and iterators on the following lines
+//	%#v     a Go expression that reconstructs the Kind,
+//	        e.g. "kind.For[map[string]int]()"
+//
+// Example usage:
+//
+//	k := kind.Of(map[string][]int{})
+//	fmt.Printf("%+v\n", k)
+//	// map[string][]int (map)
+//	//   key: string (string)
+//	//   value: []int (slice|int)
+func (k *Kind) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's', 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), k.name)
+	case 'v':
+		switch {
+		case f.Flag('#'):
+			fmt.Fprint(f, k.goString())
+		case f.Flag('+'):
+			var b strings.Builder
+			k.writeTree(&b, "", 0)
+			fmt.Fprint(f, strings.TrimSuffix(b.String(), "\n"))
+		default:
+			fmt.Fprint(f, k.flagString())
+		}
+	default:
+		fmt.Fprintf(f, "%%!%c(*kind.Kind=%s)", verb, k.name)
+	}
+}
+
+// flagString returns the name with the set type flags.
+func (k *Kind) flagString() string {
+	var set []string
+	for i, f := range k.flags() {
+		if f {
+			set = append(set, flagNames[i])
+		}
+	}
+
+	return k.name + " (" + strings.Join(set, "|") + ")"
+}
+
+// writeTree writes the Kind and its child Kinds to b, one per line,
+// with the children indented under their parent.
+func (k *Kind) writeTree(b *strings.Builder, label string, depth int) {
+	b.WriteString(strings.Repeat("  ", depth) + label + k.flagString() + "\n")
+	if k.children == nil || depth >= maxDescriptorDepth {
+		return
+	}
+
+	c := k.children.get()
+	if c.key != nil {
+		c.key.writeTree(b, "key: ", depth+1)
+	}
+
+	if c.value != nil {
+		c.value.writeTree(b, "value: ", depth+1)
+	}
+}
+
+// goString returns a Go expression that reconstructs the Kind.
+func (k *Kind) goString() string {
+	switch {
+	case k.rtype != nil:
+		return "kind.For[" + k.rtype.String() + "]()"
+	case k.isNil && k.name == "nil":
+		return "kind.Of(nil)"
+	}
+
+	d := k.Descriptor()
+	return fmt.Sprintf("kind.FromDescriptor(%s)", descriptorString(d))
+}
+
+// descriptorString returns the Go literal of the Descriptor.
+func descriptorString(d *Descriptor) string {
+	fields := []string{fmt.Sprintf("Name: %q", d.Name)}
+	if d.Type != "" {
+		fields = append(fields, fmt.Sprintf("Type: %q", d.Type))
+	}
+
+	if d.Flags != 0 {
+		fields = append(fields, fmt.Sprintf("Flags: %#x", d.Flags))
+	}
+
+	if d.Key != nil {
+		fields = append(fields, "Key: "+descriptorString(d.Key))
+	}
+
+	if d.Value != nil {
+		fields = append(fields, "Value: "+descriptorString(d.Value))
+	}
+
+	return "&kind.Descriptor{" + strings.Join(fields, ", ") + "}"
+}
@@ -0,0 +1,69 @@
+package kind
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestFormat tests the Kind.Format method.
+func TestFormat(t *testing.T) {
+	undefined := &Kind{typeNode: &typeNode{name: "T", isUndefined: true}}
+	partial := &Kind{typeNode: &typeNode{
+		name:     "map[string]T",
+		isMap:    true,
+		children: newChildKinds(Of(""), undefined),
+	}}
+
+	tests := []struct {
+		format   string
+		kind     *Kind
+		expected string
+	}{
+		{"%s", Of(1), "int"},
+		{"%6s|", Of(1), "   int|"},
+		{"%q", Of(""), `"string"`},
+		{"%v", Of([]*int{}), "[]*int (pointer|slice|int)"},
+		{"%v", Of(nil), "nil (nil)"},
+		{"%d", Of(1), "%!d(*kind.Kind=int)"},
+		{
+			"%+v",
+			Of(map[string][]int{}),
+			"map[string][]int (map)\n" +
+				"  key: string (string)\n" +
+				"  value: []int (slice|int)",
+		},
+		{
+			"%+v",
+			Of(map[int]chan bool{}),
+			"map[int]chan bool (map)\n" +
+				"  key: int (int)\n" +
+				"  value: chan bool (channel)\n" +
+				"    value: bool (bool)",
+		},
+		{"%#v", Of(map[string]int{}), "kind.For[map[string]int]()"},
+		{"%#v", Of(nil), "kind.Of(nil)"},
+		{
+			"%#v",
+			partial,
+			`kind.FromDescriptor(&kind.Descriptor{Name: "map[string]T", ` +
+				`Flags: 0x200, Key: &kind.Descriptor{Name: "string", ` +
+				`Type: "string", Flags: 0x20000}, ` +
+				`Value: &kind.Descriptor{Name: "T", Flags: 0x1}})`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.kind); got != tt.expected {
+				t.Errorf("Expected %q, but got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestFlagNames tests that every type flag has a name.
+func TestFlagNames(t *testing.T) {
+	if n := len(new(typeNode).flagPtrs()); n != len(flagNames) {
+		t.Errorf("Expected %d flag names, but got %d", n, len(flagNames))
+	}
+}
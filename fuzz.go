@@ -0,0 +1,360 @@
+package kind
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// ErrFuzzType is returned by FuzzEncode and FuzzDecode when the type
+// of the Kind, or a type in it, cannot be mapped to bytes: interfaces,
+// functions, channels and unsafe pointers, or there is no type at all.
+var ErrFuzzType = errors.New("kind: type cannot be fuzz encoded")
+
+// FuzzEncode returns the flat byte form of the value of the type
+// represented by the Kind instance, which FuzzDecode turns back into
+// the value, so structured values can seed fuzz targets through
+// testing.F. The form has no framing: numbers are little-endian with
+// the size of their type, lengths of strings are uvarints, lengths of
+// slices and maps are uvarints increased by one, with zero for nil,
+// pointers start with a presence byte, and structs hold their exported
+// fields in order. Unexported fields are not encoded. It returns
+// ErrKindMismatch if the value has another type, ErrFuzzType if the type
+// cannot be encoded, and ErrCyclicValue if the value refers to itself.
+//
+// Example usage:
+//
+//	k := kind.For[Request]()
+//
+//	f.Fuzz(func(t *testing.T, data []byte) {
+//		v, err := k.FuzzDecode(data)
+//		if err != nil {
+//			t.Fatal(err)
+//		}
+//		handle(v.(Request))
+//	})
+func (k *Kind) FuzzEncode(v interface{}) ([]byte, error) {
+	if err := k.checkFuzzType(); err != nil {
+		return nil, err
+	}
+
+	if t := reflect.TypeOf(v); t != k.rtype {
+		return nil, mismatch("", k.rtype, t)
+	}
+
+	e := &fuzzEncoder{seen: map[uintptr]bool{}}
+	if err := e.encode(reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+
+	return e.data, nil
+}
+
+// FuzzDecode returns the value of the type represented by the Kind
+// instance from its flat byte form, see FuzzEncode. Any bytes, such as
+// the ones mutated by a fuzzer, are decoded: missing bytes are read as
+// zeros, lengths are limited by the number of remaining bytes, and extra
+// bytes are ignored. It returns ErrFuzzType if the type cannot be decoded.
+func (k *Kind) FuzzDecode(data []byte) (interface{}, error) {
+	if err := k.checkFuzzType(); err != nil {
+		return nil, err
+	}
+
+	v := reflect.New(k.rtype).Elem()
+	d := &fuzzDecoder{data: data}
+	d.decode(v)
+
+	return v.Interface(), nil
+}
+
+// checkFuzzType returns ErrFuzzType if the type of the Kind
+// cannot be mapped to bytes.
+func (k *Kind) checkFuzzType() error {
+	if k.rtype == nil {
+		return fmt.Errorf("%w: %s has no type", ErrFuzzType, k.name)
+	}
+
+	if t := unfuzzableType(k.rtype, map[reflect.Type]bool{}); t != nil {
+		return fmt.Errorf("%w: %s", ErrFuzzType, t)
+	}
+
+	return nil
+}
+
+// unfuzzableType returns the type in t that cannot be mapped to bytes,
+// or nil. The seen types are skipped.
+func unfuzzableType(t reflect.Type, seen map[reflect.Type]bool) reflect.Type {
+	if seen[t] {
+		return nil
+	}
+
+	seen[t] = true
+	switch t.Kind() {
+	case reflect.Interface, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return t
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return unfuzzableType(t.Elem(), seen)
+	case reflect.Map:
+		if u := unfuzzableType(t.Key(), seen); u != nil {
+			return u
+		}
+
+		return unfuzzableType(t.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+
+			if u := unfuzzableType(t.Field(i).Type, seen); u != nil {
+				return u
+			}
+		}
+	}
+
+	return nil
+}
+
+// The fuzzEncoder builds the flat byte form of a value.
+type fuzzEncoder struct {
+	data []byte
+	seen map[uintptr]bool // pointers on the current path
+}
+
+// encode appends the value to the data.
+func (e *fuzzEncoder) encode(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Bool:
+		b := byte(0)
+		if v.Bool() {
+			b = 1
+		}
+
+		e.data = append(e.data, b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.appendUint(uint64(v.Int()), v.Type().Size())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		e.appendUint(v.Uint(), v.Type().Size())
+	case reflect.Float32:
+		e.appendUint(uint64(math.Float32bits(float32(v.Float()))), 4)
+	case reflect.Float64:
+		e.appendUint(math.Float64bits(v.Float()), 8)
+	case reflect.Complex64:
+		c := v.Complex()
+		e.appendUint(uint64(math.Float32bits(float32(real(c)))), 4)
+		e.appendUint(uint64(math.Float32bits(float32(imag(c)))), 4)
+	case reflect.Complex128:
+		c := v.Complex()
+		e.appendUint(math.Float64bits(real(c)), 8)
+		e.appendUint(math.Float64bits(imag(c)), 8)
+	case reflect.String:
+		e.data = binary.AppendUvarint(e.data, uint64(v.Len()))
+		e.data = append(e.data, v.String()...)
+	case reflect.Slice:
+		e.appendLen(v)
+		return e.encodeElems(v)
+	case reflect.Array:
+		return e.encodeElems(v)
+	case reflect.Map:
+		e.appendLen(v)
+		for _, key := range sortedKeys(v) {
+			if err := e.encode(key); err != nil {
+				return err
+			}
+
+			if err := e.encode(v.MapIndex(key)); err != nil {
+				return err
+			}
+		}
+	case reflect.Ptr:
+		if v.IsNil() {
+			e.data = append(e.data, 0)
+			return nil
+		}
+
+		if e.seen[v.Pointer()] {
+			return fmt.Errorf("%w: %s", ErrCyclicValue, v.Type())
+		}
+
+		e.seen[v.Pointer()] = true
+		defer delete(e.seen, v.Pointer())
+
+		e.data = append(e.data, 1)
+		return e.encode(v.Elem())
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+
+			if err := e.encode(v.Field(i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// encodeElems appends the elements of the sequence to the data.
+func (e *fuzzEncoder) encodeElems(v reflect.Value) error {
+	for i := 0; i < v.Len(); i++ {
+		if err := e.encode(v.Index(i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// appendLen appends the length of the slice or map increased by one,
+// or zero if it is nil.
+func (e *fuzzEncoder) appendLen(v reflect.Value) {
+	n := uint64(0)
+	if !v.IsNil() {
+		n = uint64(v.Len()) + 1
+	}
+
+	e.data = binary.AppendUvarint(e.data, n)
+}
+
+// appendUint appends the low size bytes of u in little-endian order.
+func (e *fuzzEncoder) appendUint(u uint64, size uintptr) {
+	for i := uintptr(0); i < size; i++ {
+		e.data = append(e.data, byte(u>>(8*i)))
+	}
+}
+
+// The fuzzDecoder reads a value from its flat byte form.
+type fuzzDecoder struct {
+	data []byte
+}
+
+// decode sets the settable value from the data.
+func (d *fuzzDecoder) decode(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(d.uint(1)&1 == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		size := v.Type().Size()
+		u := d.uint(size)
+		// Sign-extend the value from its size.
+		shift := 64 - 8*size
+		v.SetInt(int64(u<<shift) >> shift)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		v.SetUint(d.uint(v.Type().Size()))
+	case reflect.Float32:
+		v.SetFloat(float64(math.Float32frombits(uint32(d.uint(4)))))
+	case reflect.Float64:
+		v.SetFloat(math.Float64frombits(d.uint(8)))
+	case reflect.Complex64:
+		re := math.Float32frombits(uint32(d.uint(4)))
+		im := math.Float32frombits(uint32(d.uint(4)))
+		v.SetComplex(complex(float64(re), float64(im)))
+	case reflect.Complex128:
+		re := math.Float64frombits(d.uint(8))
+		im := math.Float64frombits(d.uint(8))
+		v.SetComplex(complex(re, im))
+	case reflect.String:
+		n := d.limit(d.uvarint())
+		v.SetString(string(d.data[:n]))
+		d.data = d.data[n:]
+	case reflect.Slice:
+		n, ok := d.len()
+		if !ok {
+			return
+		}
+
+		v.Set(reflect.MakeSlice(v.Type(), n, n))
+		d.decodeElems(v)
+	case reflect.Array:
+		d.decodeElems(v)
+	case reflect.Map:
+		n, ok := d.len()
+		if !ok {
+			return
+		}
+
+		m := reflect.MakeMapWithSize(v.Type(), n)
+		for i := 0; i < n; i++ {
+			key := reflect.New(v.Type().Key()).Elem()
+			elem := reflect.New(v.Type().Elem()).Elem()
+			d.decode(key)
+			d.decode(elem)
+			m.SetMapIndex(key, elem)
+		}
+
+		v.Set(m)
+	case reflect.Ptr:
+		if d.uint(1) == 0 {
+			return
+		}
+
+		p := reflect.New(v.Type().Elem())
+		d.decode(p.Elem())
+		v.Set(p)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				d.decode(v.Field(i))
+			}
+		}
+	}
+}
+
+// decodeElems sets the elements of the sequence from the data.
+func (d *fuzzDecoder) decodeElems(v reflect.Value) {
+	for i := 0; i < v.Len(); i++ {
+		d.decode(v.Index(i))
+	}
+}
+
+// uint reads size bytes in little-endian order,
+// the missing bytes are zeros.
+func (d *fuzzDecoder) uint(size uintptr) uint64 {
+	var u uint64
+	for i := uintptr(0); i < size && len(d.data) > 0; i++ {
+		u |= uint64(d.data[0]) << (8 * i)
+		d.data = d.data[1:]
+	}
+
+	return u
+}
+
+// uvarint reads an uvarint. An incomplete or overflowing
+// uvarint ends the data.
+func (d *fuzzDecoder) uvarint() uint64 {
+	u, size := binary.Uvarint(d.data)
+	if size <= 0 {
+		d.data = nil
+		return 0
+	}
+
+	d.data = d.data[size:]
+	return u
+}
+
+// len reads the length of a slice or map, see appendLen.
+// It returns false for nil.
+func (d *fuzzDecoder) len() (int, bool) {
+	u := d.uvarint()
+	if u == 0 {
+		return 0, false
+	}
+
+	return d.limit(u - 1), true
+}
+
+// limit returns the length limited by the number of the remaining
+// bytes, so corrupted lengths cannot make huge values.
+func (d *fuzzDecoder) limit(n uint64) int {
+	if n > uint64(len(d.data)) {
+		n = uint64(len(d.data))
+	}
+
+	return int(n)
+}
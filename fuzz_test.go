@@ -0,0 +1,143 @@
+package kind
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// TestFuzzEncode tests that FuzzDecode restores the values
+// encoded by FuzzEncode.
+func TestFuzzEncode(t *testing.T) {
+	type Item struct {
+		SKU    string
+		Qty    int16
+		Price  float32
+		hidden int
+	}
+
+	type Order struct {
+		ID     uint64
+		Paid   bool
+		Items  []Item
+		Notes  map[string]int8
+		Parent *Order
+		Grid   [2][2]complex64
+	}
+
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{"int", -42},
+		{"int8", int8(math.MinInt8)},
+		{"uint32", uint32(math.MaxUint32)},
+		{"float64", math.Pi},
+		{"complex128", complex(1.5, -2)},
+		{"string", "héllo"},
+		{"empty slice", []int{}},
+		{"nil map", map[int]bool(nil)},
+		{"nil pointer", (*int)(nil)},
+		{
+			"order",
+			Order{
+				ID:    7,
+				Paid:  true,
+				Items: []Item{{SKU: "a", Qty: -3, Price: 2.5}, {SKU: "bc"}},
+				Notes: map[string]int8{"x": 1, "y": -1},
+				Parent: &Order{
+					ID:   1,
+					Grid: [2][2]complex64{{1i}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := Of(tt.value)
+			data, err := k.FuzzEncode(tt.value)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			v, err := k.FuzzDecode(data)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(v, tt.value) {
+				t.Errorf("Expected %#v, but got %#v", tt.value, v)
+			}
+		})
+	}
+}
+
+// TestFuzzDecode tests that FuzzDecode accepts arbitrary bytes.
+func TestFuzzDecode(t *testing.T) {
+	type Node struct {
+		Name string
+		Kids []Node
+		Next *Node
+	}
+
+	k := For[Node]()
+	inputs := [][]byte{
+		nil,
+		{0xff},
+		{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		{5, 'a', 'b'},
+		{0, 200, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+	}
+
+	for _, data := range inputs {
+		v, err := k.FuzzDecode(data)
+		if err != nil {
+			t.Fatalf("Unexpected error for %v: %v", data, err)
+		}
+
+		if _, ok := v.(Node); !ok {
+			t.Errorf("Expected Node for %v, but got %T", data, v)
+		}
+	}
+
+	v, _ := For[[]int8]().FuzzDecode([]byte{100, 1, 0xff})
+	if !reflect.DeepEqual(v, []int8{1, -1}) {
+		t.Errorf("Expected the length limited by the data, but got %v", v)
+	}
+}
+
+// TestFuzzErrors tests the errors of FuzzEncode and FuzzDecode.
+func TestFuzzErrors(t *testing.T) {
+	type Cycle struct{ Next *Cycle }
+
+	cycle := &Cycle{}
+	cycle.Next = cycle
+
+	tests := []struct {
+		name  string
+		kind  *Kind
+		value interface{}
+		err   error
+	}{
+		{"mismatch", For[int](), int64(1), ErrKindMismatch},
+		{"interface", For[[]interface{}](), []interface{}{}, ErrFuzzType},
+		{"func field", For[struct{ F func() }](), struct{ F func() }{}, ErrFuzzType},
+		{"chan", For[chan int](), make(chan int), ErrFuzzType},
+		{"no type", Of(nil), nil, ErrFuzzType},
+		{"cycle", Of(cycle), cycle, ErrCyclicValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.kind.FuzzEncode(tt.value); !errors.Is(err, tt.err) {
+				t.Errorf("Expected %v, but got %v", tt.err, err)
+			}
+		})
+	}
+
+	if _, err := For[map[string]func()]().FuzzDecode(nil); !errors.Is(err, ErrFuzzType) {
+		t.Errorf("Expected ErrFuzzType, but got %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+package kind
+
+import "strings"
+
+// IsGeneric returns true if the Kind instance represents
+// an instantiated generic type, e.g. List[int].
+func (k *Kind) IsGeneric() bool {
+	_, args := k.splitGeneric()
+	return args != ""
+}
+
+// GenericName returns the name of the generic type without package
+// qualifier and type arguments, e.g. "List" for List[int]. It returns
+// an empty string if the Kind instance is not an instantiated generic.
+//
+// Example usage:
+//
+//	type Pair[K comparable, V any] struct {
+//		Key   K
+//		Value V
+//	}
+//
+//	k := kind.Of(Pair[string, int]{})
+//	fmt.Println(k.GenericName()) // "Pair"
+func (k *Kind) GenericName() string {
+	name, args := k.splitGeneric()
+	if args == "" {
+		return ""
+	}
+
+	return name
+}
+
+// TypeArgs returns the Kind instances of the type arguments of
+// an instantiated generic type, in declaration order. Arguments of
+// predeclared, registered and composite types are fully classified,
+// arguments of defined types that are not registered, see Register,
+// have only the name and the undefined flag. It returns nil if the
+// Kind instance is not an instantiated generic.
+//
+// Example usage:
+//
+//	k := kind.Of(Pair[string, []int]{})
+//	for _, arg := range k.TypeArgs() {
+//		fmt.Println(arg.Name()) // "string", "[]int"
+//	}
+func (k *Kind) TypeArgs() []*Kind {
+	_, args := k.splitGeneric()
+	if args == "" {
+		return nil
+	}
+
+	names := splitTypeList(args)
+	result := make([]*Kind, len(names))
+	for i, name := range names {
+		if t, err := parseTypeName(name); err == nil {
+			result[i] = ofType(t)
+		} else {
+			result[i] = &Kind{typeNode: &typeNode{
+				name:        intern(name),
+				isUndefined: true,
+			}}
+		}
+	}
+
+	return result
+}
+
+// splitGeneric returns the name of the generic type and the list of
+// its type arguments, e.g. "Pair" and "string,int" for Pair[string,int].
+// The list is empty if the type is not an instantiated generic.
+func (k *Kind) splitGeneric() (string, string) {
+	if k.rtype == nil {
+		return "", ""
+	}
+
+	// The Name method returns the name of the defined type without
+	// package qualifier, so "[" can only start the type arguments.
+	name := k.rtype.Name()
+	i := strings.IndexByte(name, '[')
+	if i < 0 || !strings.HasSuffix(name, "]") {
+		return name, ""
+	}
+
+	return name[:i], name[i+1 : len(name)-1]
+}
+
+// splitTypeList splits the comma-separated list of types,
+// ignoring commas inside brackets, parentheses and braces.
+func splitTypeList(list string) []string {
+	var result []string
+	depth, start := 0, 0
+	for i, r := range list {
+		switch r {
+		case '[', '(', '{':
+			depth++
+		case ']', ')', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				result = append(result, strings.TrimSpace(list[start:i]))
+				start = i + 1
+			}
+		}
+	}
+
+	return append(result, strings.TrimSpace(list[start:]))
+}
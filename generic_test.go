@@ -0,0 +1,119 @@
+package kind
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Pair is a generic type used in tests.
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// List is a generic type used in tests.
+type List[T any] []T
+
+// TestGeneric tests the generic instantiation metadata methods.
+func TestGeneric(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		generic string
+		args    []string
+		flags   []func(*Kind) bool
+	}{
+		{
+			name:    "pair",
+			input:   Pair[string, int]{},
+			generic: "Pair",
+			args:    []string{"string", "int"},
+			flags:   []func(*Kind) bool{(*Kind).IsString, (*Kind).IsInt},
+		},
+		{
+			name:    "composite args",
+			input:   Pair[[2]int, map[string][]*int]{},
+			generic: "Pair",
+			args:    []string{"[2]int", "map[string][]*int"},
+			flags:   []func(*Kind) bool{(*Kind).IsArray, (*Kind).IsMap},
+		},
+		{
+			name:    "list",
+			input:   List[chan<- float64]{},
+			generic: "List",
+			args:    []string{"chan<- float64"},
+			flags:   []func(*Kind) bool{(*Kind).IsChannel},
+		},
+		{
+			name:    "defined arg",
+			input:   List[Pair[int, bool]]{},
+			generic: "List",
+			args:    []string{"github.com/goloop/kind.Pair[int,bool]"},
+			flags:   []func(*Kind) bool{(*Kind).IsUndefined},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := Of(tt.input)
+			if !k.IsGeneric() || k.GenericName() != tt.generic {
+				t.Fatalf("Expected generic %s, but got %q",
+					tt.generic, k.GenericName())
+			}
+
+			args := k.TypeArgs()
+			if len(args) != len(tt.args) {
+				t.Fatalf("Expected %d type args, but got %d",
+					len(tt.args), len(args))
+			}
+
+			for i, arg := range args {
+				if arg.Name() != tt.args[i] || !tt.flags[i](arg) {
+					t.Errorf("Unexpected type arg %d: %+v", i, arg)
+				}
+			}
+		})
+	}
+}
+
+// TestNotGeneric tests the generic metadata methods for other types.
+func TestNotGeneric(t *testing.T) {
+	for _, v := range []interface{}{nil, 1, []int{}, &Pair[int, int]{}} {
+		k := Of(v)
+		if k.IsGeneric() || k.GenericName() != "" || k.TypeArgs() != nil {
+			t.Errorf("Expected no generic metadata for %T", v)
+		}
+	}
+}
+
+// TestParseTypeName tests the parseTypeName function.
+func TestParseTypeName(t *testing.T) {
+	valid := map[string]reflect.Type{
+		"int":                  reflect.TypeOf(0),
+		"byte":                 reflect.TypeOf(byte(0)),
+		"*string":              reflect.TypeOf((*string)(nil)),
+		"[]*int":               reflect.TypeOf([]*int{}),
+		"[3][]bool":            reflect.TypeOf([3][]bool{}),
+		"map[string][]int":     reflect.TypeOf(map[string][]int{}),
+		"map[int]any":          reflect.TypeOf(map[int]interface{}{}),
+		"chan int":             reflect.TypeOf(make(chan int)),
+		"<-chan error":         reflect.TypeOf(make(<-chan error)),
+		"[]interface {}":       reflect.TypeOf([]interface{}{}),
+		"map[string]struct {}": reflect.TypeOf(map[string]struct{}{}),
+	}
+
+	for name, expected := range valid {
+		if result, err := parseTypeName(name); err != nil || result != expected {
+			t.Errorf("Expected %s for %q, but got %v (%v)",
+				expected, name, result, err)
+		}
+	}
+
+	invalid := []string{"", "foo", "[]", "map[string", "[x]int",
+		"map[[]int]bool", "int int"}
+	for _, name := range invalid {
+		if _, err := parseTypeName(name); err == nil {
+			t.Errorf("Expected error for %q", name)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package kind
+
+import (
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrNoType is returned when the Kind instance has no type information,
+// e.g. it is created from nil or describes an undefined type.
+var ErrNoType = errors.New("kind: kind has no type")
+
+// GoDecl returns a Go type declaration of the given name that reproduces
+// the shape of the type represented by the Kind instance, formatted by
+// gofmt. Unnamed structs, including the structs built by reflect.StructOf,
+// are spelled out with their fields and tags, while named types nested in
+// the shape are referred to by name; the types from the same package as
+// the Kind's own type are not qualified.
+//
+// Example usage:
+//
+//	k, _ := kindjson.InferKind([]byte(`{"id": 1, "tags": ["a"]}`))
+//	decl, _ := k.GoDecl("Item")
+//	fmt.Println(decl)
+//	// type Item struct {
+//	//	Id   int64    `json:"id"`
+//	//	Tags []string `json:"tags"`
+//	// }
+func (k *Kind) GoDecl(name string) (string, error) {
+	if k.rtype == nil {
+		return "", fmt.Errorf("%w: %s", ErrNoType, k.name)
+	}
+
+	if !token.IsIdentifier(name) {
+		return "", fmt.Errorf("%w: invalid identifier %q", ErrInvalidTypeName, name)
+	}
+
+	t := k.rtype
+	g := goDecl{pkg: t.PkgPath()}
+
+	var b strings.Builder
+	b.WriteString("type " + name + " ")
+	if t.Name() != "" && predeclared[t.Name()] == nil {
+		// Declare the named type by its underlying type.
+		g.writeUnderlying(&b, t)
+	} else {
+		g.write(&b, t)
+	}
+
+	src, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return "", err
+	}
+
+	return string(src), nil
+}
+
+// The goDecl writes Go type expressions.
+type goDecl struct {
+	pkg string // package path of the declared type
+}
+
+// write writes the type expression for the type.
+func (g goDecl) write(b *strings.Builder, t reflect.Type) {
+	switch {
+	case t.Name() == "":
+		g.writeUnderlying(b, t)
+	case t.PkgPath() != "" && t.PkgPath() == g.pkg:
+		b.WriteString(t.Name())
+	default:
+		b.WriteString(t.String())
+	}
+}
+
+// writeUnderlying writes the type literal of the type,
+// with the named types nested in it written by name.
+func (g goDecl) writeUnderlying(b *strings.Builder, t reflect.Type) {
+	switch t.Kind() {
+	case reflect.Ptr:
+		b.WriteString("*")
+		g.write(b, t.Elem())
+	case reflect.Slice:
+		b.WriteString("[]")
+		g.write(b, t.Elem())
+	case reflect.Array:
+		b.WriteString("[" + strconv.Itoa(t.Len()) + "]")
+		g.write(b, t.Elem())
+	case reflect.Map:
+		b.WriteString("map[")
+		g.write(b, t.Key())
+		b.WriteString("]")
+		g.write(b, t.Elem())
+	case reflect.Chan:
+		switch t.ChanDir() {
+		case reflect.RecvDir:
+			b.WriteString("<-chan ")
+		case reflect.SendDir:
+			b.WriteString("chan<- ")
+		default:
+			b.WriteString("chan ")
+		}
+
+		g.write(b, t.Elem())
+	case reflect.Struct:
+		if t.NumField() == 0 {
+			b.WriteString("struct{}")
+			return
+		}
+
+		b.WriteString("struct {\n")
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.Anonymous {
+				b.WriteString(f.Name + " ")
+			}
+
+			g.write(b, f.Type)
+			if tag := string(f.Tag); strings.Contains(tag, "`") {
+				b.WriteString(" " + strconv.Quote(tag))
+			} else if tag != "" {
+				b.WriteString(" `" + tag + "`")
+			}
+
+			b.WriteString("\n")
+		}
+
+		b.WriteString("}")
+	default:
+		// Basic, interface and function types
+		// are spelled out by reflect.
+		b.WriteString(predeclaredName(t))
+	}
+}
+
+// predeclaredName returns the name of the basic type
+// underlying the type, or its literal for other kinds.
+func predeclaredName(t reflect.Type) string {
+	if t.Kind() <= reflect.Complex128 || t.Kind() == reflect.String {
+		return t.Kind().String()
+	}
+
+	if t.Kind() == reflect.UnsafePointer {
+		return "unsafe.Pointer"
+	}
+
+	return t.String()
+}
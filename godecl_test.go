@@ -0,0 +1,103 @@
+package kind
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestGoDecl tests the Kind.GoDecl method.
+func TestGoDecl(t *testing.T) {
+	type Celsius float64
+
+	type Address struct {
+		City string
+	}
+
+	type User struct {
+		Address
+		Name    string            `json:"name"`
+		Created time.Time         `json:"created"`
+		Home    *Address          `json:"home,omitempty"`
+		Tags    []string          `json:"tags"`
+		Meta    map[string][2]int `json:"meta"`
+		Quote   string            "note:\"a `b\""
+		Events  <-chan Celsius
+		secret  bool
+	}
+
+	inferred := reflect.StructOf([]reflect.StructField{
+		{Name: "ID", Type: reflect.TypeOf(0.0), Tag: `json:"id"`},
+		{
+			Name: "Items",
+			Type: reflect.SliceOf(reflect.StructOf([]reflect.StructField{
+				{Name: "Name", Type: reflect.TypeOf(""), Tag: `json:"name"`},
+			})),
+			Tag: `json:"items"`,
+		},
+	})
+
+	tests := []struct {
+		name     string
+		kind     *Kind
+		decl     string
+		expected string
+	}{
+		{
+			name: "named struct",
+			kind: Of(User{}),
+			decl: "User",
+			expected: "type User struct {\n" +
+				"\tAddress\n" +
+				"\tName    string            `json:\"name\"`\n" +
+				"\tCreated time.Time         `json:\"created\"`\n" +
+				"\tHome    *Address          `json:\"home,omitempty\"`\n" +
+				"\tTags    []string          `json:\"tags\"`\n" +
+				"\tMeta    map[string][2]int `json:\"meta\"`\n" +
+				"\tQuote   string            \"note:\\\"a `b\\\"\"\n" +
+				"\tEvents  <-chan Celsius\n" +
+				"\tsecret  bool\n" +
+				"}",
+		},
+		{
+			name: "inferred struct",
+			kind: OfType(inferred),
+			decl: "Order",
+			expected: "type Order struct {\n" +
+				"\tID    float64 `json:\"id\"`\n" +
+				"\tItems []struct {\n" +
+				"\t\tName string `json:\"name\"`\n" +
+				"\t} `json:\"items\"`\n" +
+				"}",
+		},
+		{"named basic", Of(Celsius(1)), "Temp", "type Temp float64"},
+		{"container", Of(map[string][]*User{}), "Users", "type Users map[string][]*kind.User"},
+		{"interface", For[error](), "Err", "type Err error"},
+		{"empty struct", Of(struct{}{}), "Set", "type Set struct{}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.kind.GoDecl(tt.decl)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if got != tt.expected {
+				t.Errorf("Expected:\n%s\nbut got:\n%s", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestGoDeclErrors tests the errors returned by the Kind.GoDecl method.
+func TestGoDeclErrors(t *testing.T) {
+	if _, err := Of(nil).GoDecl("T"); !errors.Is(err, ErrNoType) {
+		t.Errorf("Expected ErrNoType, but got %v", err)
+	}
+
+	if _, err := Of(1).GoDecl("1T"); !errors.Is(err, ErrInvalidTypeName) {
+		t.Errorf("Expected ErrInvalidTypeName, but got %v", err)
+	}
+}
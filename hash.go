@@ -0,0 +1,86 @@
+package kind
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"strconv"
+)
+
+// Hash returns a hash of the shape described by the Kind instance: its
+// type and flags, but not the stored value. Kinds of the same type have
+// the same hash, which is stable between runs and builds of a program,
+// so it can be used as a map key or stored.
+//
+// Named types are identified by their full package path, so types of
+// the same name from different packages have different hashes.
+//
+// Example usage:
+//
+//	seen := map[uint64]*kind.Kind{}
+//	for _, v := range values {
+//		k := kind.Of(v)
+//		seen[k.Hash()] = k
+//	}
+func (k *Kind) Hash() uint64 {
+	h := fnv.New64a()
+	if k.rtype != nil {
+		h.Write([]byte(canonicalName(k.rtype)))
+	} else {
+		h.Write([]byte(k.name))
+	}
+
+	h.Write([]byte{0})
+	for _, f := range k.flags() {
+		if f {
+			h.Write([]byte{1})
+		} else {
+			h.Write([]byte{0})
+		}
+	}
+
+	return h.Sum64()
+}
+
+// HashString returns the Hash as a fixed-width hexadecimal string.
+//
+// Example usage:
+//
+//	fmt.Println(kind.Of(1).HashString()) // e.g. "a3c1f0e2b4d59687"
+func (k *Kind) HashString() string {
+	return fmt.Sprintf("%016x", k.Hash())
+}
+
+// canonicalName returns the type name in which the named types are
+// qualified by their full package path instead of the package name.
+func canonicalName(t reflect.Type) string {
+	if t.Name() != "" {
+		if t.PkgPath() == "" {
+			return t.Name()
+		}
+
+		return t.PkgPath() + "." + t.Name()
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + canonicalName(t.Elem())
+	case reflect.Slice:
+		return "[]" + canonicalName(t.Elem())
+	case reflect.Array:
+		return "[" + strconv.Itoa(t.Len()) + "]" + canonicalName(t.Elem())
+	case reflect.Map:
+		return "map[" + canonicalName(t.Key()) + "]" + canonicalName(t.Elem())
+	case reflect.Chan:
+		switch t.ChanDir() {
+		case reflect.RecvDir:
+			return "<-chan " + canonicalName(t.Elem())
+		case reflect.SendDir:
+			return "chan<- " + canonicalName(t.Elem())
+		}
+
+		return "chan " + canonicalName(t.Elem())
+	}
+
+	return t.String()
+}
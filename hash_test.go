@@ -0,0 +1,63 @@
+package kind
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestHash tests the Kind.Hash and Kind.HashString methods.
+func TestHash(t *testing.T) {
+	type string2 string
+
+	tests := []struct {
+		name  string
+		a, b  *Kind
+		equal bool
+	}{
+		{"same type", Of(1), Of(2), true},
+		{"type and value", For[[]int](), Of([]int{1}), true},
+		{"different types", Of(1), Of(int64(1)), false},
+		{"named type", Of("a"), Of(string2("a")), false},
+		{"map", Of(map[string]int{}), Of(map[string]int8{}), false},
+		{"nil", Of(nil), Of(nil), true},
+		{"nil and interface", Of(nil), For[interface{}](), false},
+		{"channel direction", For[chan int](), For[<-chan int](), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Hash() == tt.b.Hash(); got != tt.equal {
+				t.Errorf("Expected equal %v, but got %v", tt.equal, got)
+			}
+
+			if len(tt.a.HashString()) != 16 {
+				t.Errorf("Expected 16 characters, but got %q",
+					tt.a.HashString())
+			}
+		})
+	}
+}
+
+// TestCanonicalName tests the canonicalName function.
+func TestCanonicalName(t *testing.T) {
+	tests := []struct {
+		input    reflect.Type
+		expected string
+	}{
+		{reflect.TypeOf(0), "int"},
+		{reflect.TypeOf(Field{}), "github.com/goloop/kind.Field"},
+		{
+			reflect.TypeOf(map[string][]*Field{}),
+			"map[string][]*github.com/goloop/kind.Field",
+		},
+		{reflect.TypeOf([2]chan<- int{}), "[2]chan<- int"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if got := canonicalName(tt.input); got != tt.expected {
+				t.Errorf("Expected %q, but got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package kind
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// The maxInterned limits the number of names interned by intern, so
+// the names of decoded descriptors, which can come from untrusted
+// input, do not grow the table without bound.
+const maxInterned = 4096
+
+// The names interns the Kind names that do not come from a type, such
+// as the names of decoded descriptors, so the Kinds with the same name
+// share one copy of it. Once the table is full, the names are no longer
+// interned.
+var names struct {
+	byName sync.Map // string -> string
+	size   atomic.Int64
+}
+
+// typeName returns the name of the type. The name returned by reflect
+// is stored with the type itself, so the Kinds of the same type share
+// it without a global table, which would keep the name of every type
+// ever analyzed, e.g. built by reflect.StructOf, after the type cache
+// evicts it.
+func typeName(t reflect.Type) string {
+	return t.String()
+}
+
+// intern returns the interned copy of the name.
+func intern(name string) string {
+	if interned, ok := names.byName.Load(name); ok {
+		return interned.(string)
+	}
+
+	if names.size.Load() >= maxInterned {
+		return name
+	}
+
+	interned, loaded := names.byName.LoadOrStore(name, name)
+	if !loaded {
+		names.size.Add(1)
+	}
+
+	return interned.(string)
+}
@@ -0,0 +1,56 @@
+package kind
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+// TestIntern tests that the Kind names are shared.
+func TestIntern(t *testing.T) {
+	same := func(a, b string) bool {
+		return unsafe.StringData(a) == unsafe.StringData(b)
+	}
+
+	type local map[string][]int
+	tests := []struct {
+		name string
+		a, b *Kind
+	}{
+		{"of", Of(local{}), Of(local{})},
+		{"field kinds", Of(Field{}).Fields()[2].Kind, Of(Field{}).Fields()[2].Kind},
+		{"type and value", OfType(reflect.TypeOf(local{})), Of(local{})},
+		{"map children", Of(local{}).MapValueKind(), Of(map[int][]int{}).MapValueKind()},
+		{"descriptor", FromDescriptor(&Descriptor{Name: strings.Repeat("T", 2)}), FromDescriptor(&Descriptor{Name: strings.Repeat("T", 2)})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.a.Name() != tt.b.Name() || !same(tt.a.Name(), tt.b.Name()) {
+				t.Errorf("Expected shared name %q, but got %q",
+					tt.a.Name(), tt.b.Name())
+			}
+		})
+	}
+}
+
+// TestTypeName tests that the typeName function returns the name stored
+// with the type, so no table keeps the names of short-lived types.
+func TestTypeName(t *testing.T) {
+	types := []reflect.Type{
+		reflect.TypeOf(map[string][]int{}),
+		reflect.StructOf([]reflect.StructField{
+			{Name: "A", Type: reflect.TypeOf(0)},
+		}),
+		reflect.FuncOf(nil, []reflect.Type{reflect.TypeOf("")}, false),
+	}
+
+	for _, typ := range types {
+		name := typeName(typ)
+		if name != typ.String() ||
+			unsafe.StringData(name) != unsafe.StringData(typ.String()) {
+			t.Errorf("Expected the name of %s to be shared", typ)
+		}
+	}
+}
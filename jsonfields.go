@@ -0,0 +1,184 @@
+package kind
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// The jsonField is a struct field as encoding/json sees it.
+type jsonField struct {
+	name   string              // json name, or Go name without a json tag
+	opts   string              // options of the json tag, e.g. "omitempty"
+	tagged bool                // name is given by the json tag
+	field  reflect.StructField // field, indexed from the outer struct
+}
+
+// jsonFields returns the fields of the struct type encoded by
+// encoding/json, in the order of the struct. The fields of embedded
+// structs without a json name are promoted, including the exported
+// fields of unexported embedded structs, and fields with the same name
+// are resolved by the encoding/json rules: the least nested field wins,
+// then the one named by a json tag, and if that leaves more than one
+// field, none of them is encoded.
+func jsonFields(t reflect.Type) []jsonField {
+	// The level is a struct type embedded at the index.
+	type level struct {
+		typ   reflect.Type
+		index []int
+	}
+
+	var fields []jsonField
+	visited := map[reflect.Type]bool{}
+	for next := []level{{typ: t}}; len(next) > 0; {
+		current := next
+		next = nil
+		for _, l := range current {
+			if visited[l.typ] {
+				continue
+			}
+
+			for i := 0; i < l.typ.NumField(); i++ {
+				sf := l.typ.Field(i)
+				ft := sf.Type
+				if ft.Name() == "" && ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+
+				if !sf.IsExported() &&
+					(!sf.Anonymous || ft.Kind() != reflect.Struct) {
+					continue
+				}
+
+				tag := sf.Tag.Get("json")
+				if tag == "-" {
+					continue
+				}
+
+				index := make([]int, len(l.index)+1)
+				copy(index, l.index)
+				index[len(l.index)] = i
+
+				name, opts, _ := strings.Cut(tag, ",")
+				if name == "" && sf.Anonymous && ft.Kind() == reflect.Struct {
+					next = append(next, level{typ: ft, index: index})
+					continue
+				}
+
+				// The fields of unexported embedded structs are
+				// promoted, but the structs themselves are not encoded.
+				if !sf.IsExported() {
+					continue
+				}
+
+				sf.Index = index
+				f := jsonField{name: name, opts: opts, tagged: name != "", field: sf}
+				if name == "" {
+					f.name = sf.Name
+				}
+
+				fields = append(fields, f)
+			}
+		}
+
+		// A type embedded twice at the same level is walked twice,
+		// so its fields conflict with each other and are dropped.
+		for _, l := range current {
+			visited[l.typ] = true
+		}
+	}
+
+	return dominantJSONFields(fields)
+}
+
+// dominantJSONFields returns the fields that win over the other fields
+// with the same name, ordered by their index.
+func dominantJSONFields(fields []jsonField) []jsonField {
+	byName := make(map[string][]jsonField, len(fields))
+	for _, f := range fields {
+		byName[f.name] = append(byName[f.name], f)
+	}
+
+	result := fields[:0:0]
+	for _, f := range fields {
+		if winner, ok := dominantJSONField(byName[f.name]); ok &&
+			sameIndex(winner.field.Index, f.field.Index) {
+			result = append(result, f)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i].field.Index, result[j].field.Index
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+
+		return len(a) < len(b)
+	})
+
+	return result
+}
+
+// dominantJSONField returns the field that wins over
+// the other fields with the same name, if there is one.
+func dominantJSONField(fields []jsonField) (jsonField, bool) {
+	depth := len(fields[0].field.Index)
+	for _, f := range fields[1:] {
+		if len(f.field.Index) < depth {
+			depth = len(f.field.Index)
+		}
+	}
+
+	var winner jsonField
+	count, tagged := 0, 0
+	for _, f := range fields {
+		if len(f.field.Index) != depth {
+			continue
+		}
+
+		count++
+		if f.tagged {
+			tagged++
+			winner = f
+		} else if tagged == 0 {
+			winner = f
+		}
+	}
+
+	if count == 1 || tagged == 1 {
+		return winner, true
+	}
+
+	return jsonField{}, false
+}
+
+// sameIndex returns true if the field indexes are equal.
+func sameIndex(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lookupJSONField returns the field with the json name of the given
+// field, falling back to its Go name.
+func lookupJSONField(fields []jsonField, f jsonField) (jsonField, bool) {
+	for _, name := range []string{f.name, f.field.Name} {
+		for _, pf := range fields {
+			if pf.name == name {
+				return pf, true
+			}
+		}
+	}
+
+	return jsonField{}, false
+}
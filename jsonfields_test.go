@@ -0,0 +1,100 @@
+package kind
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestJSONFields tests the jsonFields function.
+func TestJSONFields(t *testing.T) {
+	type Base struct {
+		ID   int
+		Name string
+	}
+
+	type Tagged struct {
+		Name string `json:"Name"`
+	}
+
+	type Other struct {
+		ID int
+	}
+
+	type base struct {
+		Secret string
+		hidden string
+	}
+
+	type Named struct {
+		Base `json:"base"`
+	}
+
+	type Recursive struct {
+		*Recursive
+		Value int
+	}
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected []string
+	}{
+		{"plain", Base{}, []string{"ID", "Name"}},
+		{
+			"promoted",
+			struct {
+				Base
+				Email string `json:"email"`
+			}{},
+			[]string{"ID", "Name", "email"},
+		},
+		{
+			"shallower field wins",
+			struct {
+				Base
+				Name string `json:"-"`
+				ID   string
+			}{},
+			[]string{"Name", "ID"},
+		},
+		{
+			"ambiguous fields are dropped",
+			struct {
+				Base
+				Other
+			}{},
+			[]string{"Name"},
+		},
+		{
+			"tagged field wins",
+			struct {
+				Base
+				Tagged
+			}{},
+			[]string{"ID", "Name"},
+		},
+		{
+			"unexported embedded struct",
+			struct {
+				base
+				Email string
+			}{},
+			[]string{"Secret", "Email"},
+		},
+		{"named embedded struct", Named{}, []string{"base"}},
+		{"recursive", Recursive{}, []string{"Value"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result []string
+			for _, f := range jsonFields(reflect.TypeOf(tt.input)) {
+				result = append(result, f.name)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package kind
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// JSONSafe returns true if the type represented by the Kind instance can
+// be encoded by encoding/json. Otherwise it returns the sorted paths of
+// the offending parts: channels, functions, complex numbers and unsafe
+// pointers, and maps whose keys are neither strings, integers nor
+// encoding.TextMarshalers. The paths name struct fields by their Go
+// names, separated by dots; the fields of embedded structs, exported or
+// not, are promoted as by encoding/json.
+// The elements of slices, arrays and maps are appended as "[]", the map
+// keys as "[key]", and the type itself is the empty path.
+//
+// Unexported fields and the fields tagged json:"-" are skipped, as are
+// the types implementing json.Marshaler or encoding.TextMarshaler and
+// the interface types, whose values are only known at run time.
+//
+// Example usage:
+//
+//	type Job struct {
+//		Name string
+//		Done chan bool
+//		Meta map[[2]int]string
+//	}
+//
+//	ok, paths := kind.For[Job]().JSONSafe()
+//	fmt.Println(ok, paths) // false [Done Meta[key]]
+func (k *Kind) JSONSafe() (bool, []string) {
+	if k.rtype == nil {
+		return true, nil
+	}
+
+	c := &jsonChecker{path: map[reflect.Type]bool{}}
+	c.check(k.rtype, "")
+	sort.Strings(c.paths)
+
+	return len(c.paths) == 0, c.paths
+}
+
+// The jsonChecker collects the paths that encoding/json cannot encode.
+type jsonChecker struct {
+	path  map[reflect.Type]bool // types on the current path
+	paths []string
+}
+
+// check checks the type at the path.
+func (c *jsonChecker) check(t reflect.Type, path string) {
+	if c.path[t] || t.Implements(jsonMarshalerType) ||
+		t.Implements(textMarshalerType) {
+		return
+	}
+
+	c.path[t] = true
+	defer delete(c.path, t)
+
+	switch t.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Complex64, reflect.Complex128,
+		reflect.UnsafePointer:
+		c.paths = append(c.paths, path)
+	case reflect.Ptr:
+		c.check(t.Elem(), path)
+	case reflect.Slice, reflect.Array:
+		c.check(t.Elem(), path+"[]")
+	case reflect.Map:
+		switch key := t.Key(); {
+		case key.Kind() == reflect.String, isIntegerKind(key.Kind()),
+			key.Implements(textMarshalerType):
+		default:
+			c.paths = append(c.paths, path+"[key]")
+		}
+
+		c.check(t.Elem(), path+"[]")
+	case reflect.Struct:
+		for _, f := range jsonFields(t) {
+			c.check(f.field.Type, fieldPath(path, f.field.Name))
+		}
+	}
+}
+
+// isIntegerKind returns true if the kind is a signed
+// or unsigned integer, including uintptr.
+func isIntegerKind(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Uintptr
+}
@@ -0,0 +1,58 @@
+package kind
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestJSONSafe tests the JSONSafe method.
+func TestJSONSafe(t *testing.T) {
+	type Base struct {
+		Callback func()
+	}
+
+	type hooks struct {
+		OnDone  func()
+		private chan int
+	}
+
+	type Job struct {
+		Base
+		hooks
+		Name     string
+		Done     chan bool
+		Meta     map[[2]int]string
+		Counts   map[int]complex64
+		Started  time.Time
+		Raw      json.RawMessage
+		Ignored  chan int `json:"-"`
+		internal chan int
+		Children []*Job
+		Any      interface{}
+	}
+
+	tests := []struct {
+		name  string
+		input interface{}
+		paths []string
+	}{
+		{"safe", map[string][]int{}, nil},
+		{"struct", Job{}, []string{"Callback", "Counts[]", "Done", "Meta[key]", "OnDone"}},
+		{"slice", []map[float64]int{}, []string{"[][key]"}},
+		{"root", make(chan int), []string{""}},
+		{"marshaler", time.Time{}, nil},
+		{"nil", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, paths := Of(tt.input).JSONSafe()
+			if ok != (len(tt.paths) == 0) ||
+				!reflect.DeepEqual(paths, tt.paths) {
+				t.Errorf("Expected %v, but got %v %v", tt.paths, ok, paths)
+			}
+		})
+	}
+}
@@ -28,7 +28,8 @@
 // (e.g., AsBool, AsString, AsInt, AsFloat32, etc.).
 //
 // Additionally, Kind provides MapKeyKind and MapValueKind methods to get
-// the Kind instances of the key and value types of a map, respectively.
+// the Kind instances of the key and value types of a map, respectively,
+// and ChanElemKind to get the Kind instance of the channel element type.
 //
 // The package is useful for situations where type introspection or reflection
 // is needed to understand the nature of Go values, especially in cases where
@@ -36,10 +37,13 @@
 //
 // Note: The package assumes that the value passed to the Of function is
 // a valid Go value, and it does not handle all possible types. For instance,
-// certain edge cases like unsafe pointers, function pointers, and unexported
-// fields in structs might not be fully supported. Users should exercise
+// certain edge cases like unexported fields in structs might not be
+// fully supported. Users should exercise
 // caution and test thoroughly in their specific use cases.
 //
+// Kind instances are immutable and safe for concurrent use by multiple
+// goroutines, see the Kind type for details.
+//
 // This package is written in pure Go and does not have
 // any external dependencies.
 package kind
@@ -47,68 +51,88 @@ package kind
 import (
 	"reflect"
 	"strings"
+	"sync"
+	"unicode"
 )
 
 // Kind is a struct that represents detailed information about the type of an instance.
+//
+// A Kind instance is immutable: its fields are set once by the function
+// that creates it and never change afterward. Only two methods modify
+// the receiver: UnmarshalBinary overwrites it with the decoded Kind and
+// must be called before the instance is shared, and Release resets the
+// instance acquired by AcquireOf or AcquireWith, which must not be used
+// after the call. The type information is held by a type node shared by
+// the instances of the same type, such as the ones created by Of for
+// many values, so an instance itself only adds the value. Child Kinds,
+// such as the kinds of map keys and values, are built once on first
+// access and are shared the same way. So a Kind instance can be used by
+// multiple goroutines at the same time without additional
+// synchronization.
 type Kind struct {
-	name            string      // name of the type
-	value           interface{} // original value
-	mapKeyKind      *Kind       // representing the key type of a map
-	mapValueKind    *Kind       // representing the value type of a map
-	isMap           bool        // value is a map type
-	isUndefined     bool        // type is undefined (never used)
-	isNil           bool        // value is nil
-	isPointer       bool        // value is a pointer type
-	isArray         bool        // value is an array type
-	isSlice         bool        // value is a slice type
-	isSliceOfSlices bool        // value is a slice of slices ([][]int)
-	isArrayOfSlices bool        // value is an array of slices ([5][]int)
-	isSliceOfArrays bool        // value is a slice of arrays ([][5]int)
-	isArrayOfArrays bool        // value is an array of arrays ([5][5]int)
-	isStruct        bool        // value is a struct type
-	isInterface     bool        // value is an interface type
-	isFunction      bool        // value is a function type
-	isChannel       bool        // value is a channel type
-	isBool          bool        // value is of bool type
-	isString        bool        // value is of string type
-	isInt8          bool        // value is of int8 type
-	isInt16         bool        // value is of int16 type
-	isInt32         bool        // value is of int32 type
-	isInt64         bool        // value is of int64 type
-	isUint8         bool        // value is of uint8 type
-	isUint16        bool        // value is of uint16 type
-	isUint32        bool        // value is of uint32 type
-	isUint64        bool        // value is of uint64 type
-	isInt           bool        // value is of int type
-	isUint          bool        // value is of uint type
-	isUintptr       bool        // value is of uintptr type
-	isFloat32       bool        // value is of float32 type
-	isFloat64       bool        // value is of float64 type
-	isComplex64     bool        // value is of complex64 type
-	isComplex128    bool        // value is of complex128 type
+	*typeNode             // shared type information
+	value     interface{} // original value
+	pooled    bool        // instance is acquired from the pool
+}
+
+// The typeNode holds the type information of Kind instances. A node is
+// built once by the function that creates the Kind and is never modified
+// afterward, so it can be shared by any number of Kind instances.
+type typeNode struct {
+	name            string       // name of the type
+	rtype           reflect.Type // type of the value, nil for nil value
+	children        *childKinds  // child Kinds of map, channel or iterator
+	truncated       bool         // DeepOf stopped analysis at this Kind
+	cycle           []string     // path to the recurrence of a recursive type
+	hasUnexported   bool         // struct has unexported fields at any depth
+	isMap           bool         // value is a map type
+	isUndefined     bool         // type is undefined (never used)
+	isNil           bool         // value is nil
+	isPointer       bool         // value is a pointer type
+	isArray         bool         // value is an array type
+	isSlice         bool         // value is a slice type
+	isSliceOfSlices bool         // value is a slice of slices ([][]int)
+	isArrayOfSlices bool         // value is an array of slices ([5][]int)
+	isSliceOfArrays bool         // value is a slice of arrays ([][5]int)
+	isArrayOfArrays bool         // value is an array of arrays ([5][5]int)
+	isStruct        bool         // value is a struct type
+	isInterface     bool         // value is an interface type
+	isFunction      bool         // value is a function type
+	isChannel       bool         // value is a channel type
+	isSeq           bool         // value is an iterator (iter.Seq)
+	isSeq2          bool         // value is a pair iterator (iter.Seq2)
+	isBool          bool         // value is of bool type
+	isString        bool         // value is of string type
+	isInt8          bool         // value is of int8 type
+	isInt16         bool         // value is of int16 type
+	isInt32         bool         // value is of int32 type
+	isInt64         bool         // value is of int64 type
+	isUint8         bool         // value is of uint8 type
+	isUint16        bool         // value is of uint16 type
+	isUint32        bool         // value is of uint32 type
+	isUint64        bool         // value is of uint64 type
+	isInt           bool         // value is of int type
+	isUint          bool         // value is of uint type
+	isUintptr       bool         // value is of uintptr type
+	isUnsafePointer bool         // value is of unsafe.Pointer type
+	isFloat32       bool         // value is of float32 type
+	isFloat64       bool         // value is of float64 type
+	isComplex64     bool         // value is of complex64 type
+	isComplex128    bool         // value is of complex128 type
 }
 
+// The nilNode is the type node of the Kind instances representing nil.
+var nilNode = &typeNode{name: "nil", isNil: true}
+
 // IsComplex returns true if the Kind instance represents a complex type.
 // Types like int, uint, string, etc are simple types. That is, they can be
 // determined by one indicator, for example, IsInt(), IsUint(), IsString, etc..
 // Types like struct, map, slice are complex types, for example:
 // map[string]int == IsMap() and IsString() and IsInt() - have several attr.
 func (k *Kind) IsComplex() bool {
-	fields := []bool{
-		k.isUndefined, k.isNil, k.isPointer, k.isArray, k.isSlice,
-		k.isSliceOfSlices, k.isArrayOfSlices, k.isSliceOfArrays,
-		k.isArrayOfArrays, k.isMap, k.isStruct, k.isInterface,
-		k.isFunction, k.isChannel, k.isBool, k.isString,
-		k.isInt8, k.isInt16, k.isInt32, k.isInt64,
-		k.isUint8, k.isUint16, k.isUint32, k.isUint64,
-		k.isInt, k.isUint, k.isUintptr,
-		k.isFloat32, k.isFloat64,
-		k.isComplex64, k.isComplex128,
-	}
-
 	// Count the number of active attributes.
 	count := 0
-	for _, field := range fields {
+	for _, field := range k.flags() {
 		if field {
 			count++
 		}
@@ -121,22 +145,149 @@ func (k *Kind) IsComplex() bool {
 	return count > 2
 }
 
+// flags returns the type flags of the Kind instance in a fixed order.
+func (k *typeNode) flags() []bool {
+	ptrs := k.flagPtrs()
+	flags := make([]bool, len(ptrs))
+	for i, p := range ptrs {
+		flags[i] = *p
+	}
+
+	return flags
+}
+
+// flagPtrs returns pointers to the type flags of the Kind instance in
+// a fixed order. The order is part of the binary encoding of Kind, so
+// new flags must be appended to the end.
+func (k *typeNode) flagPtrs() []*bool {
+	return []*bool{
+		&k.isUndefined, &k.isNil, &k.isPointer, &k.isArray, &k.isSlice,
+		&k.isSliceOfSlices, &k.isArrayOfSlices, &k.isSliceOfArrays,
+		&k.isArrayOfArrays, &k.isMap, &k.isStruct, &k.isInterface,
+		&k.isFunction, &k.isChannel, &k.isSeq, &k.isSeq2, &k.isBool,
+		&k.isString, &k.isInt8, &k.isInt16, &k.isInt32, &k.isInt64,
+		&k.isUint8, &k.isUint16, &k.isUint32, &k.isUint64,
+		&k.isInt, &k.isUint, &k.isUintptr, &k.isUnsafePointer,
+		&k.isFloat32, &k.isFloat64,
+		&k.isComplex64, &k.isComplex128,
+	}
+}
+
 // MapKeyKind returns the Kind instance of the map key.
 func (k *Kind) MapKeyKind() *Kind {
-	if k.isMap && k.mapKeyKind != nil {
-		return k.mapKeyKind
+	if k.isMap && k.children != nil {
+		return k.children.get().key
 	}
 
-	return &Kind{name: "nil", isNil: true}
+	return &Kind{typeNode: nilNode}
 }
 
-// MapValueKind returns the Kind instance of the map key.
+// MapValueKind returns the Kind instance of the map value. For nested
+// maps, the value Kind has its own key and value Kinds.
 func (k *Kind) MapValueKind() *Kind {
-	if k.isMap && k.mapValueKind != nil {
-		return k.mapValueKind
+	if k.isMap && k.children != nil {
+		return k.children.get().value
+	}
+
+	return &Kind{typeNode: nilNode}
+}
+
+// ChanElemKind returns the Kind instance of the channel element.
+func (k *Kind) ChanElemKind() *Kind {
+	if k.isChannel && k.children != nil {
+		return k.children.get().value
+	}
+
+	return &Kind{typeNode: nilNode}
+}
+
+// SeqKeyKind returns the Kind instance of the key yielded by
+// a pair iterator (iter.Seq2).
+func (k *Kind) SeqKeyKind() *Kind {
+	if k.isSeq2 && k.children != nil {
+		return k.children.get().key
+	}
+
+	return &Kind{typeNode: nilNode}
+}
+
+// SeqValueKind returns the Kind instance of the value yielded by
+// an iterator (iter.Seq or iter.Seq2).
+func (k *Kind) SeqValueKind() *Kind {
+	if (k.isSeq || k.isSeq2) && k.children != nil {
+		return k.children.get().value
+	}
+
+	return &Kind{typeNode: nilNode}
+}
+
+// ElemKind returns the Kind instance of the element of a pointer, slice
+// or array. The flags of a Kind describe the whole chain of element
+// types, so []*User is both a slice and a pointer, while the element
+// Kind describes the next level only and tells where the pointer sits.
+//
+// Example usage:
+//
+//	k := kind.Of([]*User{})
+//	fmt.Println(k.ElemKind().IsPointer()) // true
+//	fmt.Println(kind.Of(&[]User{}).ElemKind().IsPointer()) // false
+func (k *Kind) ElemKind() *Kind {
+	if k.rtype != nil {
+		switch k.rtype.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array:
+			return ofType(k.rtype.Elem())
+		}
+	}
+
+	return &Kind{typeNode: nilNode}
+}
+
+// SequenceDepth returns the number of slice and array levels that wrap
+// the element type, e.g. 0 for int, 2 for [][]int and [3][]int, and 3
+// for [][][]int. Pointers between the levels are not counted, so both
+// *[][]int and []*[]int have the depth 2. For a Kind instance restored
+// without its type, it is derived from the name.
+//
+// Example usage:
+//
+//	k := kind.Of([][]float64{})
+//	fmt.Println(k.SequenceDepth()) // 2
+func (k *Kind) SequenceDepth() int {
+	depth := 0
+	if k.rtype != nil {
+		for t := k.rtype; ; t = t.Elem() {
+			switch t.Kind() {
+			case reflect.Slice, reflect.Array:
+				depth++
+			case reflect.Ptr:
+			default:
+				return depth
+			}
+		}
 	}
 
-	return &Kind{name: "nil", isNil: true}
+	// The name of an unnamed composite type starts with its levels.
+	_, depth = trimSequences(k.name)
+	return depth
+}
+
+// trimSequences returns the name of the element type without the
+// leading pointer, slice and array levels, and the number of removed
+// slice and array levels.
+func trimSequences(name string) (string, int) {
+	depth := 0
+	for {
+		switch i := strings.IndexByte(name, ']'); {
+		case strings.HasPrefix(name, "*"):
+			name = name[1:]
+		case strings.HasPrefix(name, "[") && i > 0 &&
+			strings.Trim(name[1:i], "0123456789") == "":
+			name = name[i+1:]
+			depth++
+		default:
+			return name, depth
+		}
+	}
 }
 
 // Name returns the name of the Kind instance.
@@ -144,6 +295,73 @@ func (k *Kind) Name() string {
 	return k.name
 }
 
+// ReflectKind returns the reflect.Kind of the outermost type represented
+// by the Kind instance, e.g. reflect.Slice for []*int, or reflect.Invalid
+// for nil. For a Kind instance restored without its type, such as by
+// FromDescriptor for an unknown type, it is derived from the name and
+// the flags.
+func (k *Kind) ReflectKind() reflect.Kind {
+	if k.rtype != nil {
+		return k.rtype.Kind()
+	}
+
+	// The name of an unnamed composite type starts with its kind.
+	switch name := k.name; {
+	case strings.HasPrefix(name, "*"):
+		return reflect.Ptr
+	case strings.HasPrefix(name, "[]"):
+		return reflect.Slice
+	case strings.HasPrefix(name, "["):
+		return reflect.Array
+	case strings.HasPrefix(name, "map["):
+		return reflect.Map
+	case strings.HasPrefix(name, "chan") || strings.HasPrefix(name, "<-chan"):
+		return reflect.Chan
+	}
+
+	// The flags describe the whole chain of element types,
+	// the outermost one is guessed by the usual nesting.
+	kinds := []struct {
+		flag bool
+		kind reflect.Kind
+	}{
+		{k.isPointer, reflect.Ptr},
+		{k.isSlice || k.isSliceOfSlices || k.isSliceOfArrays, reflect.Slice},
+		{k.isArray || k.isArrayOfSlices || k.isArrayOfArrays, reflect.Array},
+		{k.isMap, reflect.Map},
+		{k.isChannel, reflect.Chan},
+		{k.isFunction, reflect.Func},
+		{k.isStruct, reflect.Struct},
+		{k.isInterface, reflect.Interface},
+		{k.isBool, reflect.Bool},
+		{k.isString, reflect.String},
+		{k.isInt, reflect.Int},
+		{k.isInt8, reflect.Int8},
+		{k.isInt16, reflect.Int16},
+		{k.isInt32, reflect.Int32},
+		{k.isInt64, reflect.Int64},
+		{k.isUint, reflect.Uint},
+		{k.isUint8, reflect.Uint8},
+		{k.isUint16, reflect.Uint16},
+		{k.isUint32, reflect.Uint32},
+		{k.isUint64, reflect.Uint64},
+		{k.isUintptr, reflect.Uintptr},
+		{k.isFloat32, reflect.Float32},
+		{k.isFloat64, reflect.Float64},
+		{k.isComplex64, reflect.Complex64},
+		{k.isComplex128, reflect.Complex128},
+		{k.isUnsafePointer, reflect.UnsafePointer},
+	}
+
+	for _, c := range kinds {
+		if c.flag {
+			return c.kind
+		}
+	}
+
+	return reflect.Invalid
+}
+
 // IsUndefined returns true if the Kind instance represents an undefined type.
 func (k *Kind) IsUndefined() bool {
 	return k.isUndefined
@@ -198,6 +416,49 @@ func (k *Kind) IsMap() bool {
 	return k.isMap
 }
 
+// IsMapOfMaps returns true if the Kind instance represents a map
+// whose values are maps, e.g. map[string]map[string]int. Unlike IsMap,
+// it does not look through pointers, slices and arrays, so neither
+// []map[string]map[string]int nor map[string][]map[string]int count.
+func (k *Kind) IsMapOfMaps() bool {
+	if k.rtype == nil || k.rtype.Kind() != reflect.Map {
+		return false
+	}
+
+	return k.rtype.Elem().Kind() == reflect.Map
+}
+
+// IsMapWithStringKeys returns true if the Kind instance represents
+// a map whose keys are strings, including named string types.
+func (k *Kind) IsMapWithStringKeys() bool {
+	if k.rtype == nil || k.rtype.Kind() != reflect.Map {
+		return false
+	}
+
+	return k.rtype.Key().Kind() == reflect.String
+}
+
+// IsPointerToStruct returns true if the Kind instance represents
+// a pointer to a struct, e.g. *User, but not **User.
+func (k *Kind) IsPointerToStruct() bool {
+	if k.rtype == nil || k.rtype.Kind() != reflect.Ptr {
+		return false
+	}
+
+	return k.rtype.Elem().Kind() == reflect.Struct
+}
+
+// IsSliceOfStructs returns true if the Kind instance represents a slice
+// of structs, e.g. []User. A slice of pointers to structs is not one,
+// use ElemKind().IsPointerToStruct() to check for []*User.
+func (k *Kind) IsSliceOfStructs() bool {
+	if k.rtype == nil || k.rtype.Kind() != reflect.Slice {
+		return false
+	}
+
+	return k.rtype.Elem().Kind() == reflect.Struct
+}
+
 // IsStruct returns true if the Kind instance represents a struct type.
 func (k *Kind) IsStruct() bool {
 	return k.isStruct
@@ -218,6 +479,18 @@ func (k *Kind) IsChannel() bool {
 	return k.isChannel
 }
 
+// IsSeq returns true if the Kind instance represents an iterator
+// function of the iter.Seq shape: func(yield func(V) bool).
+func (k *Kind) IsSeq() bool {
+	return k.isSeq
+}
+
+// IsSeq2 returns true if the Kind instance represents an iterator
+// function of the iter.Seq2 shape: func(yield func(K, V) bool).
+func (k *Kind) IsSeq2() bool {
+	return k.isSeq2
+}
+
 // IsBool returns true if the Kind instance represents a bool type.
 func (k *Kind) IsBool() bool {
 	return k.isBool
@@ -283,6 +556,12 @@ func (k *Kind) IsUintptr() bool {
 	return k.isUintptr
 }
 
+// IsUnsafePointer returns true if the Kind instance represents
+// an unsafe.Pointer type.
+func (k *Kind) IsUnsafePointer() bool {
+	return k.isUnsafePointer
+}
+
 // IsFloat32 returns true if the Kind instance represents a float32 type.
 func (k *Kind) IsFloat32() bool {
 	return k.isFloat32
@@ -335,7 +614,79 @@ func (k *Kind) IsSigned() bool {
 		k.isFloat32 || k.isFloat64 || k.isComplex64 || k.isComplex128
 }
 
-// Is returns true if the name of the Kind instance is equal to the given name.
+// IsScalar returns true if the Kind instance represents a bool, string
+// or number type. Unlike the other predicates, the category methods
+// IsScalar, IsSequence, IsContainer and IsComposite look at the type
+// itself only, so []int is a sequence but not a scalar, and *int is
+// neither of them.
+//
+// Example usage:
+//
+//	switch k := kind.Of(v); {
+//	case k.IsScalar():
+//		// print the value
+//	case k.IsContainer():
+//		// iterate over the elements
+//	}
+func (k *Kind) IsScalar() bool {
+	if k.rtype == nil {
+		return false
+	}
+
+	switch k.rtype.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16,
+		reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64,
+		reflect.Complex64, reflect.Complex128:
+		return true
+	}
+
+	return false
+}
+
+// IsSequence returns true if the Kind instance represents a slice
+// or array type, including slices and arrays of slices and arrays.
+func (k *Kind) IsSequence() bool {
+	if k.rtype == nil {
+		return false
+	}
+
+	switch k.rtype.Kind() {
+	case reflect.Slice, reflect.Array:
+		return true
+	}
+
+	return false
+}
+
+// IsContainer returns true if the Kind instance represents
+// a sequence, map or channel type.
+func (k *Kind) IsContainer() bool {
+	if k.IsSequence() {
+		return true
+	}
+
+	return k.rtype != nil &&
+		(k.rtype.Kind() == reflect.Map || k.rtype.Kind() == reflect.Chan)
+}
+
+// IsComposite returns true if the Kind instance represents
+// a struct or container type.
+func (k *Kind) IsComposite() bool {
+	if k.IsContainer() {
+		return true
+	}
+
+	return k.rtype != nil && k.rtype.Kind() == reflect.Struct
+}
+
+// Is returns true if the name of the Kind instance is equal to the given
+// name. The names are compared ignoring case and white space, and with
+// the aliases byte, rune and any resolved to uint8, int32 and interface{},
+// so "[]byte" matches []uint8 and "map[string] any" matches
+// map[string]interface{}. The names registered by RegisterAlias are
+// matched by their functions.
 //
 // Example usage:
 //
@@ -345,7 +696,51 @@ func (k *Kind) IsSigned() bool {
 //	kind := kind.Of([]int{1, 2, 3})
 //	fmt.Println(kind.Is("[]int")) // true
 func (k *Kind) Is(name string) bool {
-	return k.name == strings.Replace(strings.ToLower(name), " ", "", -1)
+	if k.name == name ||
+		strings.EqualFold(normalizeName(k.name), normalizeName(name)) {
+		return true
+	}
+
+	if match, ok := aliasMatch(name); ok {
+		return match(k)
+	}
+
+	return false
+}
+
+// The nameAliases maps the predeclared aliases to the type names.
+var nameAliases = map[string]string{
+	"byte": "uint8",
+	"rune": "int32",
+	"any":  "interface{}",
+}
+
+// normalizeName returns the type name with the aliases resolved
+// and the white space removed.
+func normalizeName(name string) string {
+	var b strings.Builder
+	b.Grow(len(name))
+	for _, token := range strings.FieldsFunc(name, unicode.IsSpace) {
+		for len(token) > 0 {
+			end := strings.IndexAny(token, "[]*(),;{}")
+			switch {
+			case end < 0:
+				end = len(token)
+			case end == 0:
+				end = 1
+			}
+
+			ident := token[:end]
+			if alias, ok := nameAliases[ident]; ok {
+				ident = alias
+			}
+
+			b.WriteString(ident)
+			token = token[end:]
+		}
+	}
+
+	return b.String()
 }
 
 // String returns the name of the Kind instance.
@@ -508,83 +903,316 @@ func (k *Kind) AsComplex128() (complex128, bool) {
 //	fmt.Println(kind.IsSlice(), kind.IsInt(), kind.Name()) // true true "[]int"
 func Of(v interface{}) *Kind {
 	k := new(Kind)
-	k.value = v
+	k.init(v)
+
+	return k
+}
 
+// init fills the Kind instance with the type details of the given
+// value. The type details are copied from the type cache.
+func (k *Kind) init(v interface{}) {
 	if v == nil {
-		k.isNil = true
-		k.name = "nil"
+		*k = Kind{typeNode: nilNode}
+		return
+	}
+
+	*k = Kind{typeNode: cachedNode(reflect.TypeOf(v)), value: v}
+}
+
+// OfType returns a Kind instance that represents the given type.
+// The Kind has no value. Unlike Of, it can represent interface types.
+//
+// Example usage:
+//
+//	t := reflect.TypeOf((*error)(nil)).Elem()
+//	kind := kind.OfType(t)
+//	fmt.Println(kind.IsInterface(), kind.Name()) // true "error"
+func OfType(t reflect.Type) *Kind {
+	if t == nil {
+		return Of(nil)
+	}
+
+	return ofType(t)
+}
 
-		return k
+// OfBoth returns the static and the dynamic Kind instances of the variable
+// the pointer refers to. The static Kind represents the declared type of
+// the variable, e.g. an interface type, and the dynamic Kind represents
+// the type of its current value, like Of; both hold the current value.
+// If the argument is not a non-nil pointer, both Kind instances
+// represent the argument itself.
+//
+// Example usage:
+//
+//	var err error = os.ErrNotExist
+//	static, dynamic := kind.OfBoth(&err)
+//	fmt.Println(static.Name(), dynamic.Name()) // "error" "*errors.errorString"
+func OfBoth(ptrToVar interface{}) (*Kind, *Kind) {
+	p := reflect.ValueOf(ptrToVar)
+	if p.Kind() != reflect.Ptr || p.IsNil() {
+		k := Of(ptrToVar)
+		return k, k.Clone()
 	}
 
-	t := reflect.TypeOf(v)
-	k.name = t.String()
+	v := p.Elem()
+	static := &Kind{typeNode: cachedNode(v.Type()), value: v.Interface()}
+	return static, Of(v.Interface())
+}
 
-	level := 0
-	checkComplexTypes(k, t, level)
+// For returns a Kind instance that represents the type parameter T.
+// The Kind has no value. Unlike Of, it can represent interface types.
+//
+// Example usage:
+//
+//	kind := kind.For[fmt.Stringer]()
+//	fmt.Println(kind.IsInterface(), kind.Name()) // true "fmt.Stringer"
+func For[T any]() *Kind {
+	return ofType(reflect.TypeOf((*T)(nil)).Elem())
+}
 
-	return k
+// ofType returns a Kind instance that represents the given type
+// without a value. It is used to build child Kinds, such as the kinds
+// of map keys and values or struct fields. The Kinds of the same type
+// share the type node held by the type cache, so each type is analyzed
+// only once, see cachedNode.
+func ofType(t reflect.Type) *Kind {
+	return &Kind{typeNode: cachedNode(t)}
 }
 
-// checkComplexTypes checks for complex types like slices,
-// arrays, pointers, etc.
+// buildKind returns a Kind instance with its own type node for the type,
+// for the type cache and for the Kinds whose node is completed later,
+// such as the Kinds of DeepOf trees.
 //
-// This function is called recursively.
-func checkComplexTypes(k *Kind, t reflect.Type, level int) {
-	multiSequence := func() bool {
-		return k.isSliceOfSlices || k.isSliceOfArrays ||
-			k.isArrayOfSlices || k.isArrayOfArrays
+// The type is analyzed into a node on the stack first, so the Kind, its
+// type node and, for maps, channels and iterators, its child Kinds are
+// allocated at once.
+func buildKind(t reflect.Type) *Kind {
+	var n typeNode
+	ct := analyzeType(&n, t)
+	if ct == nil {
+		b := &kindNode{node: n}
+		b.kind.typeNode = &b.node
+		return &b.kind
+	}
+
+	b := &parentKindNode{kindNode: kindNode{node: n}, children: childKinds{t: ct}}
+	b.node.children = &b.children
+	b.kind.typeNode = &b.node
+	return &b.kind
+}
+
+// The kindNode is a Kind allocated at once with its type node.
+type kindNode struct {
+	kind Kind
+	node typeNode
+}
+
+// The parentKindNode is a kindNode allocated at once with its
+// child Kinds, which are built on first access.
+type parentKindNode struct {
+	kindNode
+	children childKinds
+}
+
+// init builds the Kind of the type in place and returns it.
+func (b *kindNode) init(t reflect.Type) *Kind {
+	if ct := analyzeType(&b.node, t); ct != nil {
+		b.node.children = &childKinds{t: ct}
 	}
 
+	b.kind.typeNode = &b.node
+	return &b.kind
+}
+
+// analyzeType fills the type node with the details of the type and
+// returns the map, channel or iterator type the child Kinds of the node
+// are built from, or nil if the node has no children.
+func analyzeType(n *typeNode, t reflect.Type) reflect.Type {
+	n.name, n.rtype = typeName(t), t
+	ct := checkComplexTypes(n, t)
+	if observer.Load() != nil {
+		if err := checkSupport(t); err != nil {
+			notify(func() Event {
+				return Event{Type: EventUnsupported, RType: t, Err: err}
+			})
+		}
+	}
+
+	return ct
+}
+
+// The childKinds holds the child Kind instances of a map, channel or
+// iterator. The children are built from the type on first access, so
+// callers that never look inside pay nothing for them.
+type childKinds struct {
+	once   sync.Once
+	t      reflect.Type // map, channel or iterator type
+	key    *Kind        // key of a map or iter.Seq2
+	value  *Kind        // value of a map or iterator, channel element
+	fields []*Kind      // kinds of struct fields, set only by DeepOf
+}
+
+// newChildKinds returns the already built child Kinds.
+func newChildKinds(key, value *Kind) *childKinds {
+	c := &childKinds{key: key, value: value}
+	c.once.Do(func() {})
+
+	return c
+}
+
+// get builds the child Kinds on first call and returns c.
+func (c *childKinds) get() *childKinds {
+	c.once.Do(func() {
+		key, value := childTypes(c.t)
+		if key != nil {
+			c.key = ofType(key)
+		}
+
+		if value != nil {
+			c.value = ofType(value)
+		}
+	})
+
+	return c
+}
+
+// childTypes returns the key and value types of the map, the element
+// type of the channel, or the key and value types of the iterator.
+// The missing types are nil.
+func childTypes(t reflect.Type) (reflect.Type, reflect.Type) {
 	switch t.Kind() {
-	case reflect.Slice:
-		if t.Elem().Kind() == reflect.Slice {
-			k.isSliceOfSlices = true
-		} else if t.Elem().Kind() == reflect.Array {
-			k.isSliceOfArrays = true
-		} else if !multiSequence() {
-			k.isSlice = true
+	case reflect.Map:
+		return t.Key(), t.Elem()
+	case reflect.Chan:
+		return nil, t.Elem()
+	case reflect.Func:
+		if yield := seqYield(t); yield != nil {
+			if yield.NumIn() == 2 {
+				return yield.In(0), yield.In(1)
+			}
+
+			return nil, yield.In(0)
 		}
+	}
 
-		// Continue checking for more complex types.
-		checkComplexTypes(k, t.Elem(), level+1)
-	case reflect.Array:
-		if t.Elem().Kind() == reflect.Slice {
-			k.isArrayOfSlices = true
-		} else if t.Elem().Kind() == reflect.Array {
-			k.isArrayOfArrays = true
-		} else if !multiSequence() {
-			k.isArray = true
+	return nil, nil
+}
+
+// checkComplexTypes checks for complex types like slices,
+// arrays, pointers, etc., and returns the type the child Kinds
+// are built from, see analyzeType.
+//
+// The element types of sequences and pointers are checked in a loop,
+// one level per iteration, the other types end the analysis. The flags
+// of the chain are collected in local variables and the element type and
+// its reflect kind are taken once per level and carried over. A chain
+// that leads back to one of its types, such as type P *P, ends where
+// the type repeats.
+func checkComplexTypes(n *typeNode, t reflect.Type) reflect.Type {
+	var (
+		ptr, slice, array            bool
+		sliceOfSlices, sliceOfArrays bool
+		arrayOfSlices, arrayOfArrays bool
+		mark                         reflect.Type // type of the repeat check
+	)
+
+	kind := t.Kind()
+	for level := 0; ; level++ {
+		if level == DeepRecursionLevel {
+			notifyDeepRecursion(n.rtype, level)
+		}
+
+		if kind != reflect.Slice && kind != reflect.Array && kind != reflect.Ptr {
+			break
+		}
+
+		// Past DeepRecursionLevel the chain is checked for a repeat
+		// by comparing its types with the type marked at the levels
+		// of powers of two, which finds any loop in the chain.
+		if level >= DeepRecursionLevel {
+			if t == mark {
+				break
+			}
+
+			if level&(level-1) == 0 {
+				mark = t
+			}
 		}
 
-		// Continue checking for more complex types.
-		checkComplexTypes(k, t.Elem(), level+1)
-	case reflect.Ptr:
-		k.isPointer = true
-		// Continue checking for more complex types.
-		checkComplexTypes(k, t.Elem(), level+1)
+		elem := t.Elem()
+		elemKind := elem.Kind()
+		multi := sliceOfSlices || sliceOfArrays || arrayOfSlices || arrayOfArrays
+		switch {
+		case kind == reflect.Ptr:
+			ptr = true
+		case kind == reflect.Slice && elemKind == reflect.Slice:
+			sliceOfSlices = true
+		case kind == reflect.Slice && elemKind == reflect.Array:
+			sliceOfArrays = true
+		case kind == reflect.Slice:
+			slice = slice || !multi
+		case elemKind == reflect.Slice:
+			arrayOfSlices = true
+		case elemKind == reflect.Array:
+			arrayOfArrays = true
+		default:
+			array = array || !multi
+		}
+
+		t, kind = elem, elemKind
+	}
+
+	n.isPointer, n.isSlice, n.isArray = ptr, slice, array
+	n.isSliceOfSlices, n.isSliceOfArrays = sliceOfSlices, sliceOfArrays
+	n.isArrayOfSlices, n.isArrayOfArrays = arrayOfSlices, arrayOfArrays
+
+	return checkElemType(n, t, kind)
+}
+
+// notifyDeepRecursion sends the EventDeepRecursion event.
+func notifyDeepRecursion(t reflect.Type, depth int) {
+	notify(func() Event {
+		return Event{Type: EventDeepRecursion, RType: t, Depth: depth}
+	})
+}
+
+// checkElemType checks the type that ends the chain of sequences and
+// pointers analyzed by checkComplexTypes, and returns the type if the
+// child Kinds are built from it.
+func checkElemType(k *typeNode, t reflect.Type, kind reflect.Kind) reflect.Type {
+	switch kind {
 	case reflect.Map:
 		k.isMap = true
-		k.mapKeyKind = &Kind{name: t.Key().String()}
-		k.mapValueKind = &Kind{name: t.Elem().String()}
-		checkComplexTypes(k.mapKeyKind, t.Key(), 0)    // another level
-		checkComplexTypes(k.mapValueKind, t.Elem(), 0) // another level
+		return t // another level
 	case reflect.Chan:
 		k.isChannel = true
-		checkComplexTypes(k, t.Elem(), level+1)
+		return t // another level
+	case reflect.Func:
+		k.isFunction = true
+		// For function, we stop the recursion, because its signature can
+		// have many different types. The name describes the signature.
+		// The element types of iterators are represented by child Kinds
+		// like map keys and values.
+		if yield := seqYield(t); yield != nil {
+			k.isSeq = yield.NumIn() == 1
+			k.isSeq2 = yield.NumIn() == 2
+			return t // another level
+		}
 	case reflect.Struct:
 		k.isStruct = true
+		k.hasUnexported = hasUnexportedFields(t, map[reflect.Type]bool{})
 		// For struct, we stop the recursion,
 		// because it could have many different types of fields.
 
-	// We cannot define an interface because an empty interface is nil,
-	// and if an object is passed through an interface, its type is a struct.
-	//  case reflect.Interface:
-	//  	k.isInterface = true
-	//  	// For interface, we also stop the recursion,
-	//  	// because it could have many different types of methods.
+	case reflect.Interface:
+		// The Of function never sees an interface type, because a value
+		// passed through an interface has its dynamic type. But element
+		// types ([]interface{}) and types passed to OfType can be it.
+		k.isInterface = true
+		// For interface, we also stop the recursion,
+		// because it could have many different types of methods.
 	default:
-		switch t.Kind() {
+		switch kind {
 		case reflect.Bool:
 			k.isBool = true
 		case reflect.String:
@@ -611,6 +1239,8 @@ func checkComplexTypes(k *Kind, t reflect.Type, level int) {
 			k.isUint = true
 		case reflect.Uintptr:
 			k.isUintptr = true
+		case reflect.UnsafePointer:
+			k.isUnsafePointer = true
 		case reflect.Float32:
 			k.isFloat32 = true
 		case reflect.Float64:
@@ -627,4 +1257,23 @@ func checkComplexTypes(k *Kind, t reflect.Type, level int) {
 			//  	k.isUndefined = true
 		}
 	}
+
+	return nil
+}
+
+// seqYield returns the type of the yield function if the function type
+// has the shape of iter.Seq or iter.Seq2, otherwise it returns nil.
+func seqYield(t reflect.Type) reflect.Type {
+	if t.NumIn() != 1 || t.NumOut() != 0 || t.IsVariadic() {
+		return nil
+	}
+
+	yield := t.In(0)
+	if yield.Kind() != reflect.Func || yield.IsVariadic() ||
+		yield.NumIn() < 1 || yield.NumIn() > 2 ||
+		yield.NumOut() != 1 || yield.Out(0).Kind() != reflect.Bool {
+		return nil
+	}
+
+	return yield
 }
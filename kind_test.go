@@ -1,9 +1,12 @@
 package kind
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"sync"
 	"testing"
+	"unsafe"
 )
 
 // deepEqualKind compares two Kind structs and returns true if they are equal.
@@ -51,6 +54,8 @@ func deepEqualKind(a, b *Kind, prefixes ...string) (bool, string) {
 	appendDiff("isInterface", a.isInterface, b.isInterface)
 	appendDiff("isFunction", a.isFunction, b.isFunction)
 	appendDiff("isChannel", a.isChannel, b.isChannel)
+	appendDiff("isSeq", a.isSeq, b.isSeq)
+	appendDiff("isSeq2", a.isSeq2, b.isSeq2)
 	appendDiff("isBool", a.isBool, b.isBool)
 	appendDiff("isString", a.isString, b.isString)
 	appendDiff("isInt8", a.isInt8, b.isInt8)
@@ -64,6 +69,7 @@ func deepEqualKind(a, b *Kind, prefixes ...string) (bool, string) {
 	appendDiff("isInt", a.isInt, b.isInt)
 	appendDiff("isUint", a.isUint, b.isUint)
 	appendDiff("isUintptr", a.isUintptr, b.isUintptr)
+	appendDiff("isUnsafePointer", a.isUnsafePointer, b.isUnsafePointer)
 	appendDiff("isFloat32", a.isFloat32, b.isFloat32)
 	appendDiff("isFloat64", a.isFloat64, b.isFloat64)
 	appendDiff("isComplex64", a.isComplex64, b.isComplex64)
@@ -74,11 +80,11 @@ func deepEqualKind(a, b *Kind, prefixes ...string) (bool, string) {
 	mapDiff := ""
 	if a.isMap && b.isMap {
 
-		if ok, r := deepEqualKind(a.mapKeyKind, b.mapKeyKind, "\t"); !ok {
+		if ok, r := deepEqualKind(a.MapKeyKind(), b.MapKeyKind(), "\t"); !ok {
 			mapDiff += fmt.Sprintf("mapKeyKind:\n%s", r)
 		}
 
-		if ok, r := deepEqualKind(a.mapValueKind, b.mapValueKind, "\t"); !ok {
+		if ok, r := deepEqualKind(a.MapValueKind(), b.MapValueKind(), "\t"); !ok {
 			mapDiff += fmt.Sprintf("mapValueKind:\n%s", r)
 		}
 
@@ -88,12 +94,24 @@ func deepEqualKind(a, b *Kind, prefixes ...string) (bool, string) {
 		}
 	}
 
+	// Channel has a special case for element type.
+	chanDiff := ""
+	if a.isChannel && b.isChannel {
+		if ok, r := deepEqualKind(a.ChanElemKind(), b.ChanElemKind(), "\t"); !ok {
+			chanDiff += fmt.Sprintf("chanElemKind:\n%s", r)
+			equal = false
+			diffMap["isChannel"] = [2]string{"true", "true"}
+		}
+	}
+
 	// Generate the diff string.
 	result := ""
 	for k, v := range diffMap {
 		result += fmt.Sprintf("%s%s: %s != %s\n", prefix, k, v[0], v[1])
 		if k == "isMap" {
 			result += mapDiff
+		} else if k == "isChannel" {
+			result += chanDiff
 		}
 	}
 
@@ -110,92 +128,97 @@ func TestOfSimpleTypes(t *testing.T) {
 		{
 			name:  "bool",
 			input: true,
-			kind:  &Kind{name: "bool", isBool: true},
+			kind:  &Kind{typeNode: &typeNode{name: "bool", isBool: true}},
 		},
 		{
 			name:  "string",
 			input: "test",
-			kind:  &Kind{name: "string", isString: true},
+			kind:  &Kind{typeNode: &typeNode{name: "string", isString: true}},
 		},
 		{
 			name:  "int8",
 			input: int8(1),
-			kind:  &Kind{name: "int8", isInt8: true},
+			kind:  &Kind{typeNode: &typeNode{name: "int8", isInt8: true}},
 		},
 		{
 			name:  "int16",
 			input: int16(1),
-			kind:  &Kind{name: "int16", isInt16: true},
+			kind:  &Kind{typeNode: &typeNode{name: "int16", isInt16: true}},
 		},
 		{
 			name:  "int32",
 			input: int32(1),
-			kind:  &Kind{name: "int32", isInt32: true},
+			kind:  &Kind{typeNode: &typeNode{name: "int32", isInt32: true}},
 		},
 		{
 			name:  "int64",
 			input: int64(1),
-			kind:  &Kind{name: "int64", isInt64: true},
+			kind:  &Kind{typeNode: &typeNode{name: "int64", isInt64: true}},
 		},
 		{
 			name:  "uint8",
 			input: uint8(1),
-			kind:  &Kind{name: "uint8", isUint8: true},
+			kind:  &Kind{typeNode: &typeNode{name: "uint8", isUint8: true}},
 		},
 		{
 			name:  "uint16",
 			input: uint16(1),
-			kind:  &Kind{name: "uint16", isUint16: true},
+			kind:  &Kind{typeNode: &typeNode{name: "uint16", isUint16: true}},
 		},
 		{
 			name:  "uint32",
 			input: uint32(1),
-			kind:  &Kind{name: "uint32", isUint32: true},
+			kind:  &Kind{typeNode: &typeNode{name: "uint32", isUint32: true}},
 		},
 		{
 			name:  "uint64",
 			input: uint64(1),
-			kind:  &Kind{name: "uint64", isUint64: true},
+			kind:  &Kind{typeNode: &typeNode{name: "uint64", isUint64: true}},
 		},
 		{
 			name:  "int",
 			input: 1,
-			kind:  &Kind{name: "int", isInt: true},
+			kind:  &Kind{typeNode: &typeNode{name: "int", isInt: true}},
 		},
 		{
 			name:  "uint",
 			input: uint(1),
-			kind:  &Kind{name: "uint", isUint: true},
+			kind:  &Kind{typeNode: &typeNode{name: "uint", isUint: true}},
 		},
 		{
 			name:  "uintptr",
 			input: uintptr(1),
-			kind:  &Kind{name: "uintptr", isUintptr: true},
+			kind:  &Kind{typeNode: &typeNode{name: "uintptr", isUintptr: true}},
+		},
+		{
+			name:  "unsafe pointer",
+			input: unsafe.Pointer(new(int)),
+			kind:  &Kind{typeNode: &typeNode{name: "unsafe.Pointer", isUnsafePointer: true}},
 		},
 		{
 			name:  "float32",
 			input: float32(1),
-			kind:  &Kind{name: "float32", isFloat32: true},
+			kind:  &Kind{typeNode: &typeNode{name: "float32", isFloat32: true}},
 		},
 		{
 			name:  "float64",
 			input: float64(1),
-			kind:  &Kind{name: "float64", isFloat64: true},
+			kind:  &Kind{typeNode: &typeNode{name: "float64", isFloat64: true}},
 		},
 		{
 			name:  "complex64",
 			input: complex64(1),
-			kind:  &Kind{name: "complex64", isComplex64: true},
+			kind:  &Kind{typeNode: &typeNode{name: "complex64", isComplex64: true}},
 		},
 		{
 			name:  "complex128",
 			input: complex128(1),
-			kind:  &Kind{name: "complex128", isComplex128: true},
+			kind:  &Kind{typeNode: &typeNode{name: "complex128", isComplex128: true}},
 		},
 		{
 			name:  "nil",
 			input: nil,
-			kind:  &Kind{name: "nil", isNil: true},
+			kind:  &Kind{typeNode: &typeNode{name: "nil", isNil: true}},
 		},
 	}
 
@@ -225,106 +248,141 @@ func TestOfComplexTypes(t *testing.T) {
 		{
 			name:  "array",
 			input: [5]int{1, 2, 3, 4, 5},
-			kind: &Kind{
+			kind: &Kind{typeNode: &typeNode{
 				name:    "[5]int",
 				isArray: true,
 				isInt:   true,
-			},
+			}},
 		},
 		{
 			name:  "pointer",
 			input: new(int),
-			kind: &Kind{
+			kind: &Kind{typeNode: &typeNode{
 				name:      "*int",
 				isPointer: true,
 				isInt:     true,
-			},
+			}},
 		},
 		{
 			name:  "slice",
 			input: []int{1, 2, 3},
-			kind: &Kind{
+			kind: &Kind{typeNode: &typeNode{
 				name:    "[]int",
 				isSlice: true,
 				isInt:   true,
-			},
+			}},
 		},
 		{
 			name:  "slice of slices",
 			input: [][]int{{1, 2}, {3, 4}},
-			kind: &Kind{
+			kind: &Kind{typeNode: &typeNode{
 				name:            "[][]int",
 				isSlice:         false, // because it is slice of slices
 				isSliceOfSlices: true,
 				isInt:           true,
-			},
+			}},
 		},
 		{
 			name:  "slice of arrays",
 			input: [][2]int{{1, 2}, {3, 4}},
-			kind: &Kind{
+			kind: &Kind{typeNode: &typeNode{
 				name:            "[][2]int",
 				isSlice:         false, // because it is slice of arrays
 				isSliceOfArrays: true,
 				isInt:           true,
-			},
+			}},
 		},
 		{
 			name:  "array of slices",
 			input: [2][]int{{1, 2}, {3, 4}},
-			kind: &Kind{
+			kind: &Kind{typeNode: &typeNode{
 				name:            "[2][]int",
 				isArray:         false, // because it is slice of arrays
 				isArrayOfSlices: true,
 				isInt:           true,
-			},
+			}},
 		},
 		{
 			name:  "array of arrays",
 			input: [2][2]int{{1, 2}, {3, 4}},
-			kind: &Kind{
+			kind: &Kind{typeNode: &typeNode{
 				name:            "[2][2]int",
 				isArray:         false, // because it is slice of arrays
 				isArrayOfArrays: true,
 				isInt:           true,
-			},
+			}},
 		},
 		{
 			name:  "map of int",
 			input: map[string]int{"one": 1, "two": 2},
-			kind: &Kind{
-				name:         "map[string]int",
-				isMap:        true,
-				mapKeyKind:   &Kind{name: "string", isString: true},
-				mapValueKind: &Kind{name: "int", isInt: true},
-			},
+			kind: &Kind{typeNode: &typeNode{
+				name:  "map[string]int",
+				isMap: true,
+				children: newChildKinds(
+					&Kind{typeNode: &typeNode{name: "string", isString: true}},
+					&Kind{typeNode: &typeNode{name: "int", isInt: true}},
+				),
+			}},
 		},
 		{
 			name:  "map of slice",
 			input: map[string][]int{"one": {1}, "two": {1, 2}},
-			kind: &Kind{
-				name:         "map[string][]int",
-				isMap:        true,
-				mapKeyKind:   &Kind{name: "string", isString: true},
-				mapValueKind: &Kind{name: "[]int", isInt: true, isSlice: true},
-			},
+			kind: &Kind{typeNode: &typeNode{
+				name:  "map[string][]int",
+				isMap: true,
+				children: newChildKinds(
+					&Kind{typeNode: &typeNode{name: "string", isString: true}},
+					&Kind{typeNode: &typeNode{name: "[]int", isInt: true, isSlice: true}},
+				),
+			}},
 		},
 		{
 			name:  "channel",
 			input: make(chan int),
-			kind: &Kind{
+			kind: &Kind{typeNode: &typeNode{
 				name:      "chan int",
 				isChannel: true,
-				isInt:     true,
-			},
+				children:  newChildKinds(nil, &Kind{typeNode: &typeNode{name: "int", isInt: true}}),
+			}},
+		},
+		{
+			name:  "channel of slices",
+			input: make(chan []string),
+			kind: &Kind{typeNode: &typeNode{
+				name:      "chan []string",
+				isChannel: true,
+				children: newChildKinds(nil, &Kind{typeNode: &typeNode{
+					name:     "[]string",
+					isSlice:  true,
+					isString: true,
+				}}),
+			}},
+		},
+		{
+			name:  "slice of channels",
+			input: []<-chan bool{},
+			kind: &Kind{typeNode: &typeNode{
+				name:      "[]<-chan bool",
+				isSlice:   true,
+				isChannel: true,
+				children:  newChildKinds(nil, &Kind{typeNode: &typeNode{name: "bool", isBool: true}}),
+			}},
+		},
+		{
+			name:  "function",
+			input: func(int, ...string) error { return nil },
+			kind: &Kind{typeNode: &typeNode{
+				name:       "func(int, ...string) error",
+				isFunction: true,
+			}},
 		},
 		{
 			name:  "struct",
 			input: struct{ a int }{a: 1},
-			kind: &Kind{
+			kind: &Kind{typeNode: &typeNode{
 				name:     "struct { a int }",
 				isStruct: true,
-			},
+			}},
 		},
 	}
 
@@ -343,3 +401,501 @@ func TestOfComplexTypes(t *testing.T) {
 		})
 	}
 }
+
+// TestOfType tests the kind.OfType and kind.For functions.
+func TestOfType(t *testing.T) {
+	tests := []struct {
+		name string
+		kind *Kind
+		want *Kind
+	}{
+		{
+			name: "int",
+			kind: For[int](),
+			want: &Kind{typeNode: &typeNode{name: "int", isInt: true}},
+		},
+		{
+			name: "interface",
+			kind: For[error](),
+			want: &Kind{typeNode: &typeNode{name: "error", isInterface: true}},
+		},
+		{
+			name: "slice of interfaces",
+			kind: OfType(reflect.TypeOf([]interface{}{})),
+			want: &Kind{typeNode: &typeNode{
+				name:        "[]interface {}",
+				isSlice:     true,
+				isInterface: true,
+			}},
+		},
+		{
+			name: "nil type",
+			kind: OfType(nil),
+			want: &Kind{typeNode: &typeNode{name: "nil", isNil: true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if ok, result := deepEqualKind(tt.want, tt.kind); !ok {
+				t.Errorf("DeepEqual expected kind %+v, but got %+v:\n%s",
+					tt.want, tt.kind, result)
+			}
+		})
+	}
+}
+
+// The self-referential types lead back to themselves.
+type (
+	selfPointer *selfPointer
+	selfSlice   []selfSlice
+	selfMap     map[string]selfMap
+)
+
+// TestOfSelfReferential tests that the kind.Of function ends the
+// analysis of types that lead back to themselves.
+func TestOfSelfReferential(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+		check func(k *Kind) bool
+	}{
+		{"pointer", selfPointer(nil), (*Kind).IsPointer},
+		{"slice", selfSlice{}, (*Kind).IsSliceOfSlices},
+		{"map", selfMap{}, func(k *Kind) bool {
+			return k.MapValueKind().MapValueKind().IsMap()
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if k := Of(tt.input); !tt.check(k) {
+				t.Errorf("Unexpected kind %s", k)
+			}
+		})
+	}
+}
+
+// TestOfSeq tests the kind.Of function for iterator functions.
+func TestOfSeq(t *testing.T) {
+	seq := func(yield func(int) bool) {}
+	seq2 := func(yield func(string, []int) bool) {}
+
+	k := Of(seq)
+	if !k.IsSeq() || k.IsSeq2() || !k.IsFunction() ||
+		!k.SeqValueKind().IsInt() {
+		t.Errorf("Expected iter.Seq of int, but got %+v", k)
+	}
+
+	if !k.SeqKeyKind().IsNil() {
+		t.Errorf("Expected nil key kind, but got %s", k.SeqKeyKind())
+	}
+
+	k = Of(seq2)
+	if k.IsSeq() || !k.IsSeq2() {
+		t.Errorf("Expected iter.Seq2, but got %+v", k)
+	}
+
+	if !k.SeqKeyKind().IsString() || !k.SeqValueKind().IsSlice() ||
+		!k.SeqValueKind().IsInt() {
+		t.Errorf("Expected string and []int, but got %s and %s",
+			k.SeqKeyKind(), k.SeqValueKind())
+	}
+
+	others := []interface{}{
+		func() {},
+		func(yield func(int)) {},
+		func(yield func(int) bool) bool { return true },
+		func(yield func(int, int, int) bool) {},
+		func(yield func(...int) bool) {},
+		func(yield func() bool) {},
+	}
+
+	for _, v := range others {
+		if k := Of(v); k.IsSeq() || k.IsSeq2() || !k.IsFunction() {
+			t.Errorf("Expected function but no iterator for %T", v)
+		}
+	}
+}
+
+// TestOfBoth tests the kind.OfBoth function.
+func TestOfBoth(t *testing.T) {
+	var err error = errors.New("failed")
+	var empty interface{}
+	n := 1
+	var np *int
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		static  string
+		dynamic string
+	}{
+		{"interface", &err, "error", "*errors.errorString"},
+		{"nil interface", &empty, "interface {}", "nil"},
+		{"concrete", &n, "int", "int"},
+		{"nil pointer variable", &np, "*int", "*int"},
+		{"nil pointer", np, "*int", "*int"},
+		{"not pointer", 1, "int", "int"},
+		{"nil", nil, "nil", "nil"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			static, dynamic := OfBoth(tt.input)
+			if static.Name() != tt.static || dynamic.Name() != tt.dynamic {
+				t.Errorf("Expected %s and %s, but got %s and %s",
+					tt.static, tt.dynamic, static, dynamic)
+			}
+		})
+	}
+
+	static, dynamic := OfBoth(&err)
+	if !static.IsInterface() || static.value != err || dynamic.value != err {
+		t.Errorf("Expected the value in both kinds, but got %v and %v",
+			static.value, dynamic.value)
+	}
+}
+
+// TestReflectKind tests the ReflectKind method.
+func TestReflectKind(t *testing.T) {
+	n := 1
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected reflect.Kind
+	}{
+		{"int", 1, reflect.Int},
+		{"slice of pointers", []*int{}, reflect.Slice},
+		{"pointer to slice", &[]int{}, reflect.Ptr},
+		{"slice of slices", [][]int{}, reflect.Slice},
+		{"map", map[string]int{}, reflect.Map},
+		{"unsafe pointer", unsafe.Pointer(&n), reflect.UnsafePointer},
+		{"nil", nil, reflect.Invalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := Of(tt.input)
+			if r := k.ReflectKind(); r != tt.expected {
+				t.Errorf("Expected %s, but got %s", tt.expected, r)
+			}
+
+			// The kind restored without the type is derived from flags.
+			d := k.Descriptor()
+			d.Type = ""
+			if r := FromDescriptor(d).ReflectKind(); r != tt.expected {
+				t.Errorf("Expected %s from flags, but got %s", tt.expected, r)
+			}
+		})
+	}
+
+	if r := For[error]().ReflectKind(); r != reflect.Interface {
+		t.Errorf("Expected interface, but got %s", r)
+	}
+}
+
+// TestChildKindsLazy tests that child Kinds are built on first
+// access and then reused.
+func TestChildKindsLazy(t *testing.T) {
+	type lazy string
+
+	k := Of(map[lazy][]int{})
+	if k.children == nil || k.children.key != nil || k.children.value != nil {
+		t.Fatal("Expected child kinds to be built lazily")
+	}
+
+	key, value := k.MapKeyKind(), k.MapValueKind()
+	if !key.IsString() || !value.IsSlice() || !value.IsInt() {
+		t.Errorf("Expected string and []int, but got %s and %s", key, value)
+	}
+
+	if k.MapKeyKind() != key || k.MapValueKind() != value {
+		t.Error("Expected child kinds to be memoized")
+	}
+}
+
+// TestIsMapOfMaps tests the IsMapOfMaps method and the children
+// of nested maps.
+func TestIsMapOfMaps(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected bool
+	}{
+		{"map of maps", map[string]map[string]int{}, true},
+		{"map of map slices", map[string][]map[string]int{}, false},
+		{"map", map[string]int{}, false},
+		{"slice of maps of maps", []map[string]map[string]int{}, false},
+		{"nil", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if r := Of(tt.input).IsMapOfMaps(); r != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, r)
+			}
+		})
+	}
+
+	inner := Of(map[string]map[int]map[bool]string{}).MapValueKind()
+	if !inner.IsMapOfMaps() || !inner.MapKeyKind().IsInt() ||
+		!inner.MapValueKind().MapValueKind().IsString() {
+		t.Errorf("Expected nested map children, but got %+v", inner)
+	}
+}
+
+// TestCompositePredicates tests the IsPointerToStruct, IsSliceOfStructs
+// and IsMapWithStringKeys methods.
+func TestCompositePredicates(t *testing.T) {
+	type User struct{ Name string }
+	type Key string
+
+	tests := []struct {
+		name       string
+		input      interface{}
+		ptrStruct  bool
+		sliceOfStr bool
+		stringKeys bool
+	}{
+		{"struct", User{}, false, false, false},
+		{"pointer to struct", &User{}, true, false, false},
+		{"pointer to pointer", new(*User), false, false, false},
+		{"slice of structs", []User{}, false, true, false},
+		{"slice of pointers", []*User{}, false, false, false},
+		{"array of structs", [1]User{}, false, false, false},
+		{"pointer to slice", &[]User{}, false, false, false},
+		{"string keys", map[string]User{}, false, false, true},
+		{"named string keys", map[Key]int{}, false, false, true},
+		{"int keys", map[int]string{}, false, false, false},
+		{"nil", nil, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := Of(tt.input)
+			if r := k.IsPointerToStruct(); r != tt.ptrStruct {
+				t.Errorf("IsPointerToStruct: expected %v, but got %v",
+					tt.ptrStruct, r)
+			}
+
+			if r := k.IsSliceOfStructs(); r != tt.sliceOfStr {
+				t.Errorf("IsSliceOfStructs: expected %v, but got %v",
+					tt.sliceOfStr, r)
+			}
+
+			if r := k.IsMapWithStringKeys(); r != tt.stringKeys {
+				t.Errorf("IsMapWithStringKeys: expected %v, but got %v",
+					tt.stringKeys, r)
+			}
+		})
+	}
+
+	if !Of([]*User{}).ElemKind().IsPointerToStruct() {
+		t.Error("Expected []*User element to be a pointer to struct")
+	}
+}
+
+// TestCategories tests the IsScalar, IsSequence, IsContainer
+// and IsComposite methods.
+func TestCategories(t *testing.T) {
+	type User struct{ Name string }
+	type Name string
+
+	tests := []struct {
+		name      string
+		input     interface{}
+		scalar    bool
+		sequence  bool
+		container bool
+		composite bool
+	}{
+		{"bool", true, true, false, false, false},
+		{"named string", Name("a"), true, false, false, false},
+		{"int", 1, true, false, false, false},
+		{"complex", 1i, true, false, false, false},
+		{"pointer", new(int), false, false, false, false},
+		{"slice", []int{}, false, true, true, true},
+		{"array of slices", [1][]int{}, false, true, true, true},
+		{"map", map[string]int{}, false, false, true, true},
+		{"channel", make(chan int), false, false, true, true},
+		{"struct", User{}, false, false, false, true},
+		{"function", func() {}, false, false, false, false},
+		{"nil", nil, false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := Of(tt.input)
+			r := []bool{k.IsScalar(), k.IsSequence(),
+				k.IsContainer(), k.IsComposite()}
+			e := []bool{tt.scalar, tt.sequence, tt.container, tt.composite}
+			if !reflect.DeepEqual(r, e) {
+				t.Errorf("Expected %v, but got %v", e, r)
+			}
+		})
+	}
+}
+
+// TestElemKind tests the ElemKind method.
+func TestElemKind(t *testing.T) {
+	type User struct{ Name string }
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		pointer bool
+		elem    string
+	}{
+		{"slice of pointers", []*User{}, true, "*kind.User"},
+		{"pointer to slice", &[]User{}, false, "[]kind.User"},
+		{"array of pointers", [2]*int{}, true, "*int"},
+		{"pointer", new(int), false, "int"},
+		{"map", map[string]int{}, false, "nil"},
+		{"scalar", 1, false, "nil"},
+		{"nil", nil, false, "nil"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elem := Of(tt.input).ElemKind()
+			if elem.Name() != tt.elem || elem.IsPointer() != tt.pointer {
+				t.Errorf("Expected %s (pointer %v), but got %s (pointer %v)",
+					tt.elem, tt.pointer, elem, elem.IsPointer())
+			}
+		})
+	}
+}
+
+// TestIs tests the Is method.
+func TestIs(t *testing.T) {
+	type User struct{ Name string }
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		typeName string
+		expected bool
+	}{
+		{"exact", 42, "int", true},
+		{"case", 42, "INT", true},
+		{"other type", 42, "int64", false},
+		{"spaces", map[string]int{}, "map[string] int", true},
+		{"tabs", map[string]int{}, "map[ string ]\tint", true},
+		{"byte", []byte{}, "[]byte", true},
+		{"rune", []rune{}, "[]rune", true},
+		{"uint8", []byte{}, "[]uint8", true},
+		{"any", map[string]interface{}{}, "map[string]any", true},
+		{"interface", []interface{}{}, "[]interface{}", true},
+		{"alias prefix", []string{}, "[]anything", false},
+		{"chan", make(chan byte), "chan byte", true},
+		{"func", func(rune) error { return nil }, "func(rune) error", true},
+		{"named", User{}, "kind.User", true},
+		{"nil", nil, "nil", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if is := Of(tt.input).Is(tt.typeName); is != tt.expected {
+				t.Errorf("Expected %v for %q, but got %v",
+					tt.expected, tt.typeName, is)
+			}
+		})
+	}
+}
+
+// TestSequenceDepth tests the SequenceDepth method.
+func TestSequenceDepth(t *testing.T) {
+	type Matrix [][]float64
+
+	tests := []struct {
+		name     string
+		kind     *Kind
+		expected int
+	}{
+		{"scalar", Of(1), 0},
+		{"nil", Of(nil), 0},
+		{"map", Of(map[string][]int{}), 0},
+		{"slice", Of([]int{}), 1},
+		{"slice of slices", Of([][]int{}), 2},
+		{"array of slices", Of([3][]int{}), 2},
+		{"three levels", Of([][][]int{}), 3},
+		{"pointers", Of(&[]*[2]int{}), 2},
+		{"named", Of(Matrix{}), 2},
+		{"by name", &Kind{typeNode: &typeNode{name: "*[][4]*[]int"}}, 3},
+		{"by name of map", &Kind{typeNode: &typeNode{name: "[]map[int]int"}}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if depth := tt.kind.SequenceDepth(); depth != tt.expected {
+				t.Errorf("Expected %d, but got %d", tt.expected, depth)
+			}
+		})
+	}
+}
+
+// TestKindConcurrent tests that a shared Kind instance can be used by
+// multiple goroutines at the same time. Run it with the race detector.
+func TestKindConcurrent(t *testing.T) {
+	type item struct {
+		Tags map[string][]int
+		Next chan *item
+	}
+
+	k := Of(map[string]item{"a": {}})
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value := k.MapValueKind()
+			fields := value.Fields()
+			if !k.IsMap() || !k.MapKeyKind().IsString() ||
+				!value.IsStruct() || len(fields) != 2 ||
+				!fields[0].Kind.MapValueKind().IsSlice() ||
+				!fields[1].Kind.ChanElemKind().IsPointer() ||
+				k.String() != "map[string]kind.item" {
+				t.Errorf("Unexpected kind %+v", k)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// benchmarkKind keeps the Kinds built by the benchmarks on the heap.
+var benchmarkKind *Kind
+
+// nestedTypes are deep chains of pointers, slices and arrays.
+var nestedTypes = []reflect.Type{
+	reflect.TypeOf((***[]*[2]*int)(nil)),
+	reflect.TypeOf([][][][]*****string{}),
+	reflect.TypeOf([]*[]**[][5]*map[string]*[]int{}),
+}
+
+// BenchmarkOfType benchmarks the Kinds of deep chains of pointers, slices
+// and arrays, as they are built for map values, struct fields, etc.
+func BenchmarkOfType(b *testing.B) {
+	for _, t := range nestedTypes {
+		b.Run(t.String(), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchmarkKind = ofType(t)
+			}
+		})
+	}
+}
+
+// BenchmarkBuildKind benchmarks the analysis of deep chains of pointers,
+// slices and arrays, which is done once for each type.
+func BenchmarkBuildKind(b *testing.B) {
+	for _, t := range nestedTypes {
+		b.Run(t.String(), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				benchmarkKind = buildKind(t)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Package kindassert provides test assertions on kinds for use with
+// testing.TB. The assertions report failures with t.Errorf, listing the
+// differences found by Kind.Explain, and return whether they passed, so
+// table tests can stop early on their own terms.
+//
+// Example usage:
+//
+//	func TestDecode(t *testing.T) {
+//		v := decode(data)
+//		kindassert.IsKind(t, v, "map[string]interface{}")
+//		kindassert.SameShape(t, v, map[string]any{})
+//	}
+package kindassert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/goloop/kind"
+)
+
+// Equalf asserts that the actual Kind is equal to the expected one, see
+// Kind.Equal. The failure message is formatted from format and args and
+// followed by the differences.
+//
+// Example usage:
+//
+//	kindassert.Equalf(t, kind.For[[]int](), kind.Of(v), "case %d", i)
+func Equalf(t testing.TB, expected, actual *kind.Kind, format string, args ...interface{}) bool {
+	t.Helper()
+
+	diffs := expected.Explain(actual)
+	if len(diffs) == 0 {
+		return true
+	}
+
+	t.Errorf("%s: kinds differ:\n%s", fmt.Sprintf(format, args...), diff(diffs))
+	return false
+}
+
+// IsKind asserts that the Kind of the value has the given name, which is
+// compared like in Kind.Is.
+//
+// Example usage:
+//
+//	kindassert.IsKind(t, v, "map[string]int")
+func IsKind(t testing.TB, v interface{}, name string) bool {
+	t.Helper()
+
+	if k := kind.Of(v); !k.Is(name) {
+		t.Errorf("expected kind %s, got %s", name, k)
+		return false
+	}
+
+	return true
+}
+
+// SameShape asserts that the values have equal Kinds, see Kind.Equal,
+// so they have the same types; the values themselves are not compared.
+//
+// Example usage:
+//
+//	kindassert.SameShape(t, got, map[string][]int{})
+func SameShape(t testing.TB, a, b interface{}) bool {
+	t.Helper()
+
+	diffs := kind.Of(a).Explain(kind.Of(b))
+	if len(diffs) == 0 {
+		return true
+	}
+
+	t.Errorf("values have different shapes:\n%s", diff(diffs))
+	return false
+}
+
+// NotNilValue asserts that the value is neither nil nor a nil pointer,
+// map, slice, channel, function or interface.
+//
+// Example usage:
+//
+//	kindassert.NotNilValue(t, resp.Items)
+func NotNilValue(t testing.TB, v interface{}) bool {
+	t.Helper()
+
+	if v == nil {
+		t.Errorf("expected non-nil value, got nil")
+		return false
+	}
+
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Chan,
+		reflect.Func, reflect.Interface, reflect.UnsafePointer:
+		if rv.IsNil() {
+			t.Errorf("expected non-nil value, got nil %s", kind.Of(v))
+			return false
+		}
+	}
+
+	return true
+}
+
+// diff returns the differences one per line, in the format
+// `"path" field: expected != actual`.
+func diff(diffs []kind.Difference) string {
+	lines := make([]string, len(diffs))
+	for i, d := range diffs {
+		lines[i] = fmt.Sprintf("\t%q %s: %s != %s",
+			d.Path, d.Field, d.Expected, d.Actual)
+	}
+
+	return strings.Join(lines, "\n")
+}
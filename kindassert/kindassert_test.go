@@ -0,0 +1,103 @@
+package kindassert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/goloop/kind"
+)
+
+// The recorder is a testing.TB that records the failure messages.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+// TestAssertions tests the assertion functions.
+func TestAssertions(t *testing.T) {
+	var nilMap map[string]int
+
+	tests := []struct {
+		name   string
+		assert func(testing.TB) bool
+		pass   bool
+		output string
+	}{
+		{
+			"equal",
+			func(t testing.TB) bool {
+				return Equalf(t, kind.For[[]int](), kind.Of([]int{1}), "case %d", 1)
+			},
+			true, "",
+		},
+		{
+			"not equal",
+			func(t testing.TB) bool {
+				return Equalf(t, kind.For[map[string]int](),
+					kind.Of(map[string]int8{}), "case %d", 2)
+			},
+			false, "case 2: kinds differ:\n\t\"\" name: map[string]int != map[string]int8",
+		},
+		{
+			"is kind",
+			func(t testing.TB) bool { return IsKind(t, map[string]int{}, "map[string] int") },
+			true, "",
+		},
+		{
+			"is not kind",
+			func(t testing.TB) bool { return IsKind(t, 1, "string") },
+			false, "expected kind string, got int",
+		},
+		{
+			"same shape",
+			func(t testing.TB) bool { return SameShape(t, []string{"a"}, []string{}) },
+			true, "",
+		},
+		{
+			"different shape",
+			func(t testing.TB) bool { return SameShape(t, []int{}, []int64{}) },
+			false, "values have different shapes:\n\t\"\" name: []int != []int64",
+		},
+		{
+			"not nil",
+			func(t testing.TB) bool { return NotNilValue(t, map[string]int{}) },
+			true, "",
+		},
+		{
+			"zero scalar",
+			func(t testing.TB) bool { return NotNilValue(t, 0) },
+			true, "",
+		},
+		{
+			"nil",
+			func(t testing.TB) bool { return NotNilValue(t, nil) },
+			false, "expected non-nil value, got nil",
+		},
+		{
+			"nil map",
+			func(t testing.TB) bool { return NotNilValue(t, nilMap) },
+			false, "expected non-nil value, got nil map[string]int",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{TB: t}
+			if pass := tt.assert(r); pass != tt.pass {
+				t.Errorf("Expected %v, but got %v", tt.pass, pass)
+			}
+
+			output := strings.Join(r.errors, "\n")
+			if tt.pass && output != "" || !strings.HasPrefix(output, tt.output) {
+				t.Errorf("Expected output %q, but got %q", tt.output, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+// Package kindavro converts Kind trees into Avro schemas.
+//
+// Scalars map onto the Avro primitive types, []byte becomes bytes and
+// time.Time becomes a long with the timestamp-millis logical type.
+// Slices and arrays become arrays, maps with string keys become maps
+// and structs become records, where the field names are taken from
+// the avro tags. Pointer kinds become unions with null.
+//
+// Example usage:
+//
+//	type User struct {
+//		Name  string   `avro:"name"`
+//		Email *string  `avro:"email"`
+//		Tags  []string `avro:"tags"`
+//	}
+//
+//	data, err := kindavro.Marshal(kind.Of(User{}))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(string(data))
+package kindavro
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/goloop/kind"
+)
+
+// ErrUnsupportedKind is returned when the kind has no Avro representation.
+var ErrUnsupportedKind = errors.New("kindavro: unsupported kind")
+
+// Record is an Avro record schema.
+type Record struct {
+	Type      string  `json:"type"`
+	Name      string  `json:"name"`
+	Namespace string  `json:"namespace,omitempty"`
+	Fields    []Field `json:"fields"`
+}
+
+// Field is a field of an Avro record schema.
+type Field struct {
+	Name    string      `json:"name"`
+	Type    interface{} `json:"type"`
+	Default interface{} `json:"default,omitempty"`
+}
+
+// The nullDefault is marshaled as the null default value of a field.
+type nullDefault struct{}
+
+// MarshalJSON returns the JSON encoding of the null default.
+func (nullDefault) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// The converter tracks the records already defined in the schema,
+// Avro requires named types to be defined once and then referenced
+// by name.
+type converter struct {
+	defined map[string]bool
+}
+
+// SchemaOf returns the Avro schema for the Kind instance. The result
+// is a string for primitive types, a map for complex types, a *Record
+// for records and a slice for unions, and can be encoded as JSON.
+func SchemaOf(k *kind.Kind) (interface{}, error) {
+	c := &converter{defined: make(map[string]bool)}
+	return c.schema(k, "Record")
+}
+
+// Marshal returns the JSON encoding of the Avro schema
+// for the Kind instance.
+func Marshal(k *kind.Kind) ([]byte, error) {
+	s, err := SchemaOf(k)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(s)
+}
+
+// schema returns the Avro schema for the Kind instance,
+// the hint is used as the name of anonymous records.
+// Pointers, slices and arrays are converted one element level at a
+// time, so that the null union is placed at the level of the pointer.
+func (c *converter) schema(k *kind.Kind, hint string) (interface{}, error) {
+	if k.IsNil() {
+		return "null", nil
+	}
+
+	switch rk := k.ReflectKind(); rk {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		elem := k.ElemKind()
+		if elem.IsNil() {
+			// The Kind has no type, see flagSchema.
+			return c.flagSchema(k, hint)
+		}
+
+		if rk == reflect.Slice && elem.ReflectKind() == reflect.Uint8 {
+			return "bytes", nil
+		}
+
+		s, err := c.schema(elem, hint)
+		if err != nil {
+			return nil, err
+		}
+
+		if rk == reflect.Ptr {
+			return nullable(s), nil
+		}
+
+		return map[string]interface{}{"type": "array", "items": s}, nil
+	}
+
+	return c.base(k, hint)
+}
+
+// flagSchema returns the Avro schema of the Kind instance restored
+// without its type, e.g. by kind.FromDescriptor. Its element levels are
+// not known, so the nesting is guessed from the sequence flags, and the
+// leading "*" of the name tells a nullable array from an array of
+// nullable elements.
+func (c *converter) flagSchema(k *kind.Kind, hint string) (interface{}, error) {
+	if k.IsSlice() && k.IsUint8() && !k.IsPointer() {
+		return "bytes", nil
+	}
+
+	outer := strings.HasPrefix(k.Name(), "*")
+	s, err := c.base(k, hint)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.IsPointer() && !outer {
+		s = nullable(s)
+	}
+
+	depth := 0
+	switch {
+	case k.IsSliceOfSlices(), k.IsSliceOfArrays(),
+		k.IsArrayOfSlices(), k.IsArrayOfArrays():
+		depth = 2
+	case k.IsSlice(), k.IsArray():
+		depth = 1
+	}
+
+	for i := 0; i < depth; i++ {
+		s = map[string]interface{}{"type": "array", "items": s}
+	}
+
+	if outer {
+		s = nullable(s)
+	}
+
+	return s, nil
+}
+
+// nullable returns the union of null and the schema. A schema that
+// is already such a union is returned as is, since Avro does not allow
+// nested unions.
+func nullable(s interface{}) interface{} {
+	if u, ok := s.([]interface{}); ok && len(u) > 0 && u[0] == "null" {
+		return s
+	}
+
+	return []interface{}{"null", s}
+}
+
+// base returns the schema of the element type of the Kind,
+// ignoring the sequence and pointer flags.
+func (c *converter) base(k *kind.Kind, hint string) (interface{}, error) {
+	switch {
+	case k.IsChannel(), k.IsFunction(), k.IsAnyComplex():
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKind, k)
+	case k.IsMap():
+		if !k.MapKeyKind().IsString() || k.MapKeyKind().IsPointer() {
+			return nil, fmt.Errorf("%w: map keys must be strings: %s",
+				ErrUnsupportedKind, k)
+		}
+
+		values, err := c.schema(k.MapValueKind(), hint+"Value")
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]interface{}{"type": "map", "values": values}, nil
+	case k.IsStruct():
+		return c.record(k, hint)
+	case k.IsBool():
+		return "boolean", nil
+	case k.IsString():
+		return "string", nil
+	case k.IsInt8(), k.IsInt16(), k.IsInt32(), k.IsUint8(), k.IsUint16():
+		return "int", nil
+	case k.IsAnyInt():
+		return "long", nil
+	case k.IsFloat32():
+		return "float", nil
+	case k.IsFloat64():
+		return "double", nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedKind, k)
+}
+
+// record returns the record schema for the struct Kind, or its name
+// if the record is already defined.
+func (c *converter) record(k *kind.Kind, hint string) (interface{}, error) {
+	name, namespace := recordName(k.Name())
+	if name == "Time" && namespace == "time" {
+		return map[string]interface{}{
+			"type":        "long",
+			"logicalType": "timestamp-millis",
+		}, nil
+	}
+
+	if name == "" {
+		name = hint
+	}
+
+	fullName := name
+	if namespace != "" {
+		fullName = namespace + "." + name
+	}
+
+	if c.defined[fullName] {
+		return fullName, nil
+	}
+
+	c.defined[fullName] = true
+	r := &Record{Type: "record", Name: name, Namespace: namespace}
+	if err := c.addFields(r, k); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// addFields adds the exported fields of the struct Kind to the record,
+// the fields of embedded structs without a tag are promoted.
+func (c *converter) addFields(r *Record, k *kind.Kind) error {
+	for _, f := range k.Fields() {
+		name := f.Tag.Get("avro")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" && f.Embedded && f.Kind.IsStruct() &&
+			!f.Kind.IsSlice() && !f.Kind.IsArray() {
+			if err := c.addFields(r, f.Kind); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if !f.Exported {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		s, err := c.schema(f.Kind, r.Name+upperFirst(name))
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.Name, err)
+		}
+
+		field := Field{Name: name, Type: s}
+		if u, ok := s.([]interface{}); ok && u[0] == "null" {
+			field.Default = nullDefault{}
+		}
+
+		r.Fields = append(r.Fields, field)
+	}
+
+	return nil
+}
+
+// recordName returns the name and namespace of the defined struct
+// type, e.g. "*[]pkg.User" to "User" and "pkg". For anonymous
+// structs it returns empty strings.
+func recordName(name string) (string, string) {
+	name = strings.TrimLeft(name, "*[]0123456789")
+	name = strings.TrimPrefix(name, "chan ")
+	name = strings.TrimLeft(name, "*[]0123456789")
+	if strings.ContainsAny(name, "{} ") {
+		return "", ""
+	}
+
+	i := strings.LastIndex(name, ".")
+	if i < 0 {
+		return name, ""
+	}
+
+	return name[i+1:], name[:i]
+}
+
+// upperFirst returns the string with the first letter in upper case.
+func upperFirst(s string) string {
+	r := []rune(s)
+	if len(r) > 0 {
+		r[0] = unicode.ToUpper(r[0])
+	}
+
+	return string(r)
+}
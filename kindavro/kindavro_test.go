@@ -0,0 +1,113 @@
+package kindavro
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goloop/kind"
+)
+
+// Node is a recursive struct type used in tests.
+type Node struct {
+	Value   int64            `avro:"value"`
+	Label   *string          `avro:"label"`
+	Next    *Node            `avro:"next"`
+	Tags    []string         `avro:"tags"`
+	Attrs   map[string]int32 `avro:"attrs"`
+	Created time.Time        `avro:"created"`
+	Extra   struct{ A bool } `avro:"extra"`
+	Skip    string           `avro:"-"`
+	hidden  string
+}
+
+// TestMarshal tests the kindavro.Marshal function.
+func TestMarshal(t *testing.T) {
+	n := 1
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected string
+	}{
+		{"nil", nil, `"null"`},
+		{"bool", true, `"boolean"`},
+		{"int16", int16(1), `"int"`},
+		{"int", 1, `"long"`},
+		{"float32", float32(1), `"float"`},
+		{"float64", 1.0, `"double"`},
+		{"string", "x", `"string"`},
+		{"bytes", []byte("x"), `"bytes"`},
+		{"pointer", &n, `["null","long"]`},
+		{"slice", []string{}, `{"items":"string","type":"array"}`},
+		{"slice of pointers", []*int{}, `{"items":["null","long"],"type":"array"}`},
+		{
+			"three levels",
+			[][][]int{},
+			`{"items":{"items":{"items":"long","type":"array"},` +
+				`"type":"array"},"type":"array"}`,
+		},
+		{
+			"slice of pointers to slices",
+			[]*[]int{},
+			`{"items":["null",{"items":"long","type":"array"}],"type":"array"}`,
+		},
+		{
+			"pointer to slice",
+			&[]int{},
+			`["null",{"items":"long","type":"array"}]`,
+		},
+		{"slice of bytes", [][]byte{}, `{"items":"bytes","type":"array"}`},
+		{"pointer to pointer", new(*int), `["null","long"]`},
+		{"map", map[string]bool{}, `{"type":"map","values":"boolean"}`},
+		{
+			"time",
+			time.Time{},
+			`{"logicalType":"timestamp-millis","type":"long"}`,
+		},
+		{
+			"record",
+			Node{},
+			`{"type":"record","name":"Node","namespace":"kindavro",` +
+				`"fields":[` +
+				`{"name":"value","type":"long"},` +
+				`{"name":"label","type":["null","string"],"default":null},` +
+				`{"name":"next","type":["null","kindavro.Node"],"default":null},` +
+				`{"name":"tags","type":{"items":"string","type":"array"}},` +
+				`{"name":"attrs","type":{"type":"map","values":"int"}},` +
+				`{"name":"created","type":{"logicalType":"timestamp-millis",` +
+				`"type":"long"}},` +
+				`{"name":"extra","type":{"type":"record","name":"NodeExtra",` +
+				`"fields":[{"name":"A","type":"boolean"}]}}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Marshal(kind.Of(tt.input))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if string(data) != tt.expected {
+				t.Errorf("Expected %s, but got %s", tt.expected, data)
+			}
+		})
+	}
+}
+
+// TestMarshalErrors tests the kindavro.Marshal function
+// for unsupported kinds.
+func TestMarshalErrors(t *testing.T) {
+	tests := []interface{}{
+		make(chan int),
+		complex64(1),
+		map[int]string{},
+		struct{ C complex128 }{},
+	}
+
+	for _, v := range tests {
+		if _, err := Marshal(kind.Of(v)); !errors.Is(err, ErrUnsupportedKind) {
+			t.Errorf("Expected ErrUnsupportedKind for %T, but got %v", v, err)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// Package kindcsv infers the kinds of CSV columns.
+//
+// Each column is classified by sampling its cells: a column where every
+// non-empty cell is an integer becomes int64, a column of numbers becomes
+// float64, a column of "true"/"false" values becomes bool, a column of
+// timestamps becomes time.Time and anything else becomes string.
+//
+// Example usage:
+//
+//	data := "id,price,active\n1,9.99,true\n2,5,false\n"
+//	kinds, err := kindcsv.InferColumns(strings.NewReader(data))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, k := range kinds {
+//		fmt.Println(k.Name()) // "int64", "float64", "bool"
+//	}
+package kindcsv
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goloop/kind"
+)
+
+// DefaultSampleSize is the default number of data rows
+// used for inference.
+const DefaultSampleSize = 100
+
+// DefaultTimeLayouts are the default layouts used to
+// recognize timestamps.
+var DefaultTimeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	time.DateTime,
+	time.DateOnly,
+}
+
+// Option configures the inference.
+type Option func(*config)
+
+// The config holds the inference settings.
+type config struct {
+	header  bool     // first record is a header
+	sample  int      // number of data rows to sample, 0 - all
+	comma   rune     // field delimiter
+	layouts []string // timestamp layouts
+}
+
+// WithHeader sets whether the first record is a header and must be
+// excluded from the inference. The default is true.
+func WithHeader(header bool) Option {
+	return func(c *config) {
+		c.header = header
+	}
+}
+
+// WithSampleSize sets the number of data rows used for inference.
+// A value less than or equal to zero means all rows.
+func WithSampleSize(n int) Option {
+	return func(c *config) {
+		c.sample = n
+	}
+}
+
+// WithComma sets the field delimiter. The default is ','.
+func WithComma(comma rune) Option {
+	return func(c *config) {
+		c.comma = comma
+	}
+}
+
+// WithTimeLayouts sets the layouts used to recognize timestamps.
+func WithTimeLayouts(layouts ...string) Option {
+	return func(c *config) {
+		c.layouts = layouts
+	}
+}
+
+// The candidate is a bit mask of the kinds a column can still have.
+type candidate uint8
+
+const (
+	candInt candidate = 1 << iota
+	candFloat
+	candBool
+	candTime
+	candAll = candInt | candFloat | candBool | candTime
+)
+
+// InferColumns reads CSV data from r and returns the Kind of
+// each column.
+func InferColumns(r io.Reader, opts ...Option) ([]*kind.Kind, error) {
+	cfg := &config{
+		header:  true,
+		sample:  DefaultSampleSize,
+		comma:   ',',
+		layouts: DefaultTimeLayouts,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	reader := csv.NewReader(r)
+	reader.Comma = cfg.comma
+	reader.FieldsPerRecord = -1
+
+	var (
+		columns []candidate
+		seen    []bool // column has at least one non-empty cell
+		rows    int
+	)
+
+	for cfg.sample <= 0 || rows < cfg.sample {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		for len(columns) < len(record) {
+			columns = append(columns, candAll)
+			seen = append(seen, false)
+		}
+
+		if cfg.header {
+			cfg.header = false
+			continue
+		}
+
+		rows++
+		for i, cell := range record {
+			cell = strings.TrimSpace(cell)
+			if cell == "" {
+				continue
+			}
+
+			seen[i] = true
+			columns[i] &= cfg.classify(cell)
+		}
+	}
+
+	result := make([]*kind.Kind, len(columns))
+	for i, c := range columns {
+		if !seen[i] {
+			c = 0
+		}
+
+		result[i] = kindOf(c)
+	}
+
+	return result, nil
+}
+
+// classify returns the candidates the cell is compatible with.
+func (c *config) classify(cell string) candidate {
+	var result candidate
+	if _, err := strconv.ParseInt(cell, 10, 64); err == nil {
+		result |= candInt
+	}
+
+	if _, err := strconv.ParseFloat(cell, 64); err == nil {
+		result |= candFloat
+	}
+
+	if lc := strings.ToLower(cell); lc == "true" || lc == "false" {
+		result |= candBool
+	}
+
+	for _, layout := range c.layouts {
+		if _, err := time.Parse(layout, cell); err == nil {
+			result |= candTime
+			break
+		}
+	}
+
+	return result
+}
+
+// kindOf returns the Kind for the most specific candidate.
+func kindOf(c candidate) *kind.Kind {
+	switch {
+	case c&candInt != 0:
+		return kind.Of(int64(0))
+	case c&candFloat != 0:
+		return kind.Of(float64(0))
+	case c&candBool != 0:
+		return kind.Of(false)
+	case c&candTime != 0:
+		return kind.Of(time.Time{})
+	}
+
+	return kind.Of("")
+}
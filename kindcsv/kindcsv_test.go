@@ -0,0 +1,77 @@
+package kindcsv
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestInferColumns tests the kindcsv.InferColumns function.
+func TestInferColumns(t *testing.T) {
+	data := "id,price,active,created,name,empty\n" +
+		"1,9.99,true,2023-08-01,apple,\n" +
+		"2,5,FALSE,2023-08-02T10:00:00Z,pear,\n" +
+		",,,,,\n"
+	expected := []string{
+		"int64", "float64", "bool", "time.Time", "string", "string",
+	}
+
+	kinds, err := InferColumns(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(kinds) != len(expected) {
+		t.Fatalf("Expected %d columns, but got %d",
+			len(expected), len(kinds))
+	}
+
+	for i, k := range kinds {
+		if k.Name() != expected[i] {
+			t.Errorf("Column %d: expected %s, but got %s",
+				i, expected[i], k.Name())
+		}
+	}
+}
+
+// TestInferColumnsOptions tests the options of kindcsv.InferColumns.
+func TestInferColumnsOptions(t *testing.T) {
+	data := "1;a\n2;b\nx;c\n"
+
+	kinds, err := InferColumns(strings.NewReader(data),
+		WithHeader(false), WithComma(';'), WithSampleSize(2))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(kinds) != 2 || !kinds[0].IsInt64() || !kinds[1].IsString() {
+		t.Errorf("Expected [int64 string], but got %v", kinds)
+	}
+
+	kinds, err = InferColumns(strings.NewReader(data),
+		WithHeader(false), WithComma(';'), WithSampleSize(0))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !kinds[0].IsString() {
+		t.Errorf("Expected string, but got %s", kinds[0])
+	}
+
+	kinds, err = InferColumns(strings.NewReader("t\n01/02/2023\n"),
+		WithTimeLayouts("01/02/2006"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if kinds[0].Name() != "time.Time" {
+		t.Errorf("Expected time.Time, but got %s", kinds[0])
+	}
+}
+
+// TestInferColumnsError tests that malformed CSV returns an error.
+func TestInferColumnsError(t *testing.T) {
+	_, err := InferColumns(strings.NewReader("a,\"b\n"))
+	if err == nil {
+		t.Error("Expected error for malformed CSV")
+	}
+}
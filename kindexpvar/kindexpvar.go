@@ -0,0 +1,33 @@
+// Package kindexpvar publishes the statistics of the kind type cache
+// with the expvar package.
+//
+// It is a separate package, because importing expvar registers the
+// /debug/vars handler on http.DefaultServeMux, which the kind package
+// must not do on behalf of its users.
+//
+// Example usage:
+//
+//	func main() {
+//		kindexpvar.Publish("kind_cache")
+//		log.Fatal(http.ListenAndServe(":8080", nil))
+//	}
+//
+// The variable is reported as a JSON object:
+//
+//	"kind_cache": {"hits": 1024, "misses": 12, "size": 12, "evictions": 0}
+package kindexpvar
+
+import (
+	"expvar"
+
+	"github.com/goloop/kind"
+)
+
+// Publish publishes the cache statistics as the expvar variable with
+// the given name. Like expvar.Publish, it panics if the name is already
+// registered.
+func Publish(name string) {
+	expvar.Publish(name, expvar.Func(func() interface{} {
+		return kind.CacheStats()
+	}))
+}
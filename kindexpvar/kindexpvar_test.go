@@ -0,0 +1,29 @@
+package kindexpvar
+
+import (
+	"encoding/json"
+	"expvar"
+	"testing"
+
+	"github.com/goloop/kind"
+)
+
+// TestPublish tests the kindexpvar.Publish function.
+func TestPublish(t *testing.T) {
+	Publish("kind_cache_test")
+	kind.Of(1)
+
+	v := expvar.Get("kind_cache_test")
+	if v == nil {
+		t.Fatal("Expected published variable")
+	}
+
+	var stats kind.CacheStatistics
+	if err := json.Unmarshal([]byte(v.String()), &stats); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if stats.Size == 0 || stats.Misses == 0 {
+		t.Errorf("Expected non-empty stats, but got %+v", stats)
+	}
+}
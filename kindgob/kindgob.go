@@ -0,0 +1,26 @@
+// Package kindgob registers the Kind type with the encoding/gob package,
+// so Kind instances can be sent as values of interface types.
+//
+// Kind implements encoding.BinaryMarshaler, which gob uses for fields
+// of type *kind.Kind without registration. It is a separate package,
+// because registration links encoding/gob with its type registry into
+// the binary, which the kind package must not do on behalf of its users.
+//
+// Example usage:
+//
+//	import _ "github.com/goloop/kind/kindgob"
+//
+//	var descriptors []interface{}
+//	descriptors = append(descriptors, kind.Of(User{}))
+//	err := gob.NewEncoder(w).Encode(descriptors)
+package kindgob
+
+import (
+	"encoding/gob"
+
+	"github.com/goloop/kind"
+)
+
+func init() {
+	gob.Register(&kind.Kind{})
+}
@@ -0,0 +1,41 @@
+package kindgob
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/goloop/kind"
+)
+
+// TestRegister tests that Kind instances can be sent through gob
+// as values of interface types.
+func TestRegister(t *testing.T) {
+	input := []interface{}{kind.Of(1), kind.Of(map[string][]int{})}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(input); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var output []interface{}
+	if err := gob.NewDecoder(&buf).Decode(&output); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(output) != len(input) {
+		t.Fatalf("Expected %d values, but got %d", len(input), len(output))
+	}
+
+	for i, v := range output {
+		k, ok := v.(*kind.Kind)
+		if !ok {
+			t.Fatalf("Expected *kind.Kind, but got %T", v)
+		}
+
+		want := input[i].(*kind.Kind)
+		if k.Name() != want.Name() || k.IsMap() != want.IsMap() {
+			t.Errorf("Expected %s, but got %s", want.Name(), k.Name())
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Package kindhttp provides an http.Handler that shows the Kind trees
+// of registered values and types, like expvar does for variables, so
+// the shapes of the dynamic payloads a running service sees can be
+// inspected.
+//
+// The trees are rendered as HTML, or as JSON if the request has the
+// format=json query parameter or accepts application/json. The name
+// query parameter selects a single tree.
+//
+// Example usage:
+//
+//	h := kindhttp.NewHandler()
+//	h.Register("config", reflect.TypeOf(Config{}))
+//	http.Handle("/debug/kinds", h)
+//
+//	func handle(w http.ResponseWriter, r *http.Request) {
+//		var payload interface{}
+//		json.NewDecoder(r.Body).Decode(&payload)
+//		h.Register("last_payload", payload)
+//		// ...
+//	}
+package kindhttp
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/goloop/kind"
+)
+
+// MaxDepth limits the nesting of the rendered nodes, which is infinite
+// for recursive types like type M map[string]M.
+const MaxDepth = 16
+
+// Node is a node of a rendered Kind tree.
+type Node struct {
+	Label    string `json:"label,omitempty"` // field name, "key", "value" or "elem"
+	Name     string `json:"name"`            // type name
+	Kind     string `json:"kind"`            // reflect kind, e.g. "struct"
+	Children []Node `json:"children,omitempty"`
+}
+
+// Tree is a rendered Kind tree of a registered value or type.
+type Tree struct {
+	Name string `json:"name"` // name the value or type is registered with
+	Root Node   `json:"root"`
+}
+
+// Handler is an http.Handler that renders the Kind trees of the
+// registered values and types. It is safe for concurrent use.
+type Handler struct {
+	mu    sync.RWMutex
+	kinds map[string]*kind.Kind
+}
+
+// NewHandler returns a new Handler with no registered values.
+func NewHandler() *Handler {
+	return &Handler{kinds: make(map[string]*kind.Kind)}
+}
+
+// Register registers the value under the name, replacing the previous
+// one, so the handler renders its Kind tree. The value can be a
+// reflect.Type or a *kind.Kind to register a type without a value.
+// Registering a nil value removes the name.
+func (h *Handler) Register(name string, v interface{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	switch v := v.(type) {
+	case nil:
+		delete(h.kinds, name)
+	case *kind.Kind:
+		h.kinds[name] = v
+	case reflect.Type:
+		h.kinds[name] = kind.OfType(v)
+	default:
+		h.kinds[name] = kind.Of(v)
+	}
+}
+
+// Trees returns the rendered Kind trees of the registered values and
+// types, sorted by name. If names are given, only these are rendered.
+func (h *Handler) Trees(names ...string) []Tree {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if len(names) == 0 {
+		for name := range h.kinds {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+	}
+
+	trees := make([]Tree, 0, len(names))
+	for _, name := range names {
+		if k, ok := h.kinds[name]; ok {
+			trees = append(trees, Tree{Name: name, Root: newNode("", k, 0)})
+		}
+	}
+
+	return trees
+}
+
+// ServeHTTP renders the Kind trees as HTML or JSON.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var names []string
+	if name := r.URL.Query().Get("name"); name != "" {
+		names = []string{name}
+	}
+
+	trees := h.Trees(names...)
+	if len(names) > 0 && len(trees) == 0 {
+		http.NotFound(w, r)
+		return
+	}
+
+	if r.URL.Query().Get("format") == "json" ||
+		strings.Contains(r.Header.Get("Accept"), "application/json") {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(trees)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	page.Execute(w, trees)
+}
+
+// newNode returns the node of the Kind with its children.
+func newNode(label string, k *kind.Kind, depth int) Node {
+	n := Node{Label: label, Name: k.Name(), Kind: k.ReflectKind().String()}
+	if depth >= MaxDepth {
+		return n
+	}
+
+	child := func(label string, k *kind.Kind) {
+		n.Children = append(n.Children, newNode(label, k, depth+1))
+	}
+
+	switch k.ReflectKind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		child("elem", k.ElemKind())
+	case reflect.Map:
+		child("key", k.MapKeyKind())
+		child("value", k.MapValueKind())
+	case reflect.Chan:
+		child("elem", k.ChanElemKind())
+	case reflect.Func:
+		if k.IsSeq2() {
+			child("key", k.SeqKeyKind())
+		}
+
+		if k.IsSeq() || k.IsSeq2() {
+			child("value", k.SeqValueKind())
+		}
+	case reflect.Struct:
+		for _, f := range k.Fields() {
+			child(f.Name, f.Kind)
+		}
+	}
+
+	return n
+}
+
+// The page is the HTML template of the handler.
+var page = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html>
+<head><title>kinds</title></head>
+<body>
+{{range .}}<h2>{{.Name}}</h2>
+<ul>{{template "node" .Root}}</ul>
+{{else}}<p>No registered values.</p>
+{{end}}</body>
+</html>
+{{define "node"}}<li>{{if .Label}}<b>{{.Label}}</b>: {{end}}<code>{{.Name}}</code> <i>{{.Kind}}</i>
+{{- if .Children}}<ul>{{range .Children}}{{template "node" .}}{{end}}</ul>{{end}}</li>
+{{end}}`))
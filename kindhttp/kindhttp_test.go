@@ -0,0 +1,145 @@
+package kindhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/goloop/kind"
+)
+
+// The user is a registered type of the tests.
+type user struct {
+	Name string
+	Tags map[string][]int
+	Next *user
+}
+
+// TestTrees tests the Handler.Trees method.
+func TestTrees(t *testing.T) {
+	h := NewHandler()
+	h.Register("user", reflect.TypeOf(user{}))
+	h.Register("count", 1)
+	h.Register("kind", kind.For[[]string]())
+	h.Register("removed", 1)
+	h.Register("removed", nil)
+
+	trees := h.Trees()
+	var names []string
+	for _, tree := range trees {
+		names = append(names, tree.Name)
+	}
+
+	if strings.Join(names, ",") != "count,kind,user" {
+		t.Fatalf("Expected trees count,kind,user, but got %v", names)
+	}
+
+	expected := Node{Name: "kindhttp.user", Kind: "struct", Children: []Node{
+		{Label: "Name", Name: "string", Kind: "string"},
+		{Label: "Tags", Name: "map[string][]int", Kind: "map", Children: []Node{
+			{Label: "key", Name: "string", Kind: "string"},
+			{Label: "value", Name: "[]int", Kind: "slice", Children: []Node{
+				{Label: "elem", Name: "int", Kind: "int"},
+			}},
+		}},
+		{Label: "Next", Name: "*kindhttp.user", Kind: "ptr"},
+	}}
+
+	root := trees[2].Root
+	next := &root.Children[2]
+	if len(next.Children) != 1 || next.Children[0].Name != "kindhttp.user" {
+		t.Fatalf("Expected the pointer element, but got %+v", next.Children)
+	}
+
+	next.Children = nil
+	if !reflect.DeepEqual(root, expected) {
+		t.Errorf("Expected %+v, but got %+v", expected, root)
+	}
+
+	// The recursive type is cut at MaxDepth.
+	depth := 0
+	for n := h.Trees("user")[0].Root; len(n.Children) > 0; n = n.Children[len(n.Children)-1] {
+		depth++
+	}
+
+	if depth != MaxDepth {
+		t.Errorf("Expected depth %d, but got %d", MaxDepth, depth)
+	}
+}
+
+// TestServeHTTP tests the Handler.ServeHTTP method.
+func TestServeHTTP(t *testing.T) {
+	h := NewHandler()
+	h.Register("ids", []int{1})
+	h.Register("<script>", "x")
+
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		accept      string
+		status      int
+		contentType string
+		contains    []string
+	}{
+		{
+			"html", http.MethodGet, "/", "", http.StatusOK, "text/html",
+			[]string{"<h2>ids</h2>", "<code>[]int</code>", "&lt;script&gt;"},
+		},
+		{
+			"json", http.MethodGet, "/?format=json", "", http.StatusOK,
+			"application/json", []string{`"name":"ids"`},
+		},
+		{
+			"accept", http.MethodGet, "/?name=ids", "application/json",
+			http.StatusOK, "application/json", []string{`"kind":"slice"`},
+		},
+		{
+			"unknown name", http.MethodGet, "/?name=x", "", http.StatusNotFound,
+			"text/plain", nil,
+		},
+		{
+			"post", http.MethodPost, "/", "", http.StatusMethodNotAllowed,
+			"text/plain", nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, tt.target, nil)
+			r.Header.Set("Accept", tt.accept)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if w.Code != tt.status {
+				t.Errorf("Expected status %d, but got %d", tt.status, w.Code)
+			}
+
+			if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, tt.contentType) {
+				t.Errorf("Expected content type %s, but got %s", tt.contentType, ct)
+			}
+
+			for _, s := range tt.contains {
+				if !strings.Contains(w.Body.String(), s) {
+					t.Errorf("Expected %q in the body:\n%s", s, w.Body)
+				}
+			}
+		})
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/?format=json&name=ids", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	var trees []Tree
+	if err := json.Unmarshal(w.Body.Bytes(), &trees); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(trees) != 1 || trees[0].Root.Children[0].Kind != "int" {
+		t.Errorf("Unexpected trees %+v", trees)
+	}
+}
@@ -0,0 +1,388 @@
+// Package kindjson infers Kind trees from raw JSON documents.
+//
+// JSON values are mapped onto Go types as follows: booleans become bool,
+// strings become string (or time.Time when date recognition is enabled),
+// integral numbers become int64 and other numbers become float64. Arrays
+// become slices of the unified element type and objects become structs
+// with exported fields tagged with the original JSON keys. A null that is
+// mixed with scalar values turns them into pointers.
+//
+// Example usage:
+//
+//	k, err := kindjson.InferKind([]byte(`[1, 2, 3]`))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(k.IsSlice(), k.IsInt64(), k.Name()) // true true "[]int64"
+package kindjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/goloop/kind"
+)
+
+var (
+	// ErrTrailingData is returned when the input contains more than
+	// one JSON value.
+	ErrTrailingData = errors.New("kindjson: unexpected data after top-level value")
+
+	// ErrNotRawJSON is returned by InferRaw when the Kind does not
+	// hold a json.RawMessage value.
+	ErrNotRawJSON = errors.New("kindjson: not a json.RawMessage value")
+)
+
+// The dateLayouts are the layouts used to recognize date strings.
+var dateLayouts = []string{time.RFC3339Nano, time.RFC3339, "2006-01-02"}
+
+// Option configures the inference.
+type Option func(*config)
+
+// The config holds the inference settings.
+type config struct {
+	dates bool // recognize date strings as time.Time
+}
+
+// WithDates enables recognition of RFC 3339 and "YYYY-MM-DD"
+// strings as time.Time values.
+func WithDates() Option {
+	return func(c *config) {
+		c.dates = true
+	}
+}
+
+// InferKind returns a Kind that represents the Go type shape
+// of the given JSON document.
+//
+// Example usage:
+//
+//	k, _ := kindjson.InferKind([]byte(`{"id": 1, "tags": ["a"]}`))
+//	fmt.Println(k.IsStruct()) // true
+func InferKind(data []byte, opts ...Option) (*kind.Kind, error) {
+	t, err := InferType(data, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if t == nil {
+		return kind.Of(nil), nil
+	}
+
+	return kind.Of(reflect.New(t).Elem().Interface()), nil
+}
+
+// InferRaw returns a Kind that represents the Go type shape of the JSON
+// document held by the Kind of a json.RawMessage, see Kind.IsRawJSON, so
+// the inner shape of a raw payload is inferred only when it is needed.
+// An empty message is treated as null. It returns ErrNotRawJSON if the
+// Kind does not hold a json.RawMessage value.
+//
+// Example usage:
+//
+//	var msg struct{ Data json.RawMessage }
+//	json.Unmarshal([]byte(`{"Data": [1, 2]}`), &msg)
+//
+//	k, _ := kindjson.InferRaw(kind.Of(msg.Data))
+//	fmt.Println(k.Name()) // "[]int64"
+func InferRaw(k *kind.Kind, opts ...Option) (*kind.Kind, error) {
+	data, ok := k.Text()
+	if !k.IsRawJSON() || !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotRawJSON, k)
+	}
+
+	if strings.TrimSpace(data) == "" {
+		return kind.Of(nil), nil
+	}
+
+	return InferKind([]byte(data), opts...)
+}
+
+// InferType returns the Go type inferred from the given JSON document.
+// It returns a nil type for a document that is a single null.
+func InferType(data []byte, opts ...Option) (reflect.Type, error) {
+	cfg := &config{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	s, err := cfg.parse(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, ErrTrailingData
+	}
+
+	if s.kind == shapeNull {
+		return nil, nil
+	}
+
+	return s.toType(), nil
+}
+
+// The shapeKind is the kind of an inferred JSON shape.
+type shapeKind int
+
+const (
+	shapeNull shapeKind = iota
+	shapeBool
+	shapeInt
+	shapeFloat
+	shapeString
+	shapeTime
+	shapeArray
+	shapeObject
+	shapeAny
+)
+
+// The shape is an intermediate representation of a JSON value type.
+type shape struct {
+	kind     shapeKind
+	nullable bool     // null was seen together with this shape
+	elem     *shape   // element shape of an array, nil if array is empty
+	fields   []*field // fields of an object in document order
+}
+
+// The field is a named member of an object shape.
+type field struct {
+	key   string
+	shape *shape
+}
+
+// parse reads the next JSON value from the decoder
+// and returns its shape.
+func (c *config) parse(dec *json.Decoder) (*shape, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := tok.(type) {
+	case nil:
+		return &shape{kind: shapeNull}, nil
+	case bool:
+		return &shape{kind: shapeBool}, nil
+	case json.Number:
+		if _, err := strconv.ParseInt(v.String(), 10, 64); err == nil {
+			return &shape{kind: shapeInt}, nil
+		}
+
+		return &shape{kind: shapeFloat}, nil
+	case string:
+		if c.dates && isDate(v) {
+			return &shape{kind: shapeTime}, nil
+		}
+
+		return &shape{kind: shapeString}, nil
+	case json.Delim:
+		if v == '[' {
+			s := &shape{kind: shapeArray}
+			for dec.More() {
+				elem, err := c.parse(dec)
+				if err != nil {
+					return nil, err
+				}
+
+				s.elem = unify(s.elem, elem)
+			}
+
+			_, err := dec.Token() // closing bracket
+			return s, err
+		}
+
+		s := &shape{kind: shapeObject}
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := c.parse(dec)
+			if err != nil {
+				return nil, err
+			}
+
+			s.setField(tok.(string), value)
+		}
+
+		_, err := dec.Token() // closing brace
+		return s, err
+	}
+
+	return nil, fmt.Errorf("kindjson: unexpected token %v", tok)
+}
+
+// setField adds a field to the object shape or unifies it
+// with the existing field of the same key.
+func (s *shape) setField(key string, value *shape) {
+	for _, f := range s.fields {
+		if f.key == key {
+			f.shape = unify(f.shape, value)
+			return
+		}
+	}
+
+	s.fields = append(s.fields, &field{key: key, shape: value})
+}
+
+// unify returns a shape compatible with both given shapes.
+// The a can be nil, in which case b is returned as is.
+func unify(a, b *shape) *shape {
+	switch {
+	case a == nil:
+		return b
+	case a.kind == shapeNull:
+		b.nullable = true
+		return b
+	case b.kind == shapeNull:
+		a.nullable = true
+		return a
+	}
+
+	nullable := a.nullable || b.nullable
+	switch {
+	case a.kind == b.kind && a.kind == shapeArray:
+		if b.elem != nil {
+			a.elem = unify(a.elem, b.elem)
+		}
+	case a.kind == b.kind && a.kind == shapeObject:
+		for _, f := range b.fields {
+			a.setField(f.key, f.shape)
+		}
+	case a.kind == b.kind:
+		// Same scalar kind, nothing to merge.
+	case isNumeric(a.kind) && isNumeric(b.kind):
+		a = &shape{kind: shapeFloat}
+	case isTextual(a.kind) && isTextual(b.kind):
+		a = &shape{kind: shapeString}
+	default:
+		a = &shape{kind: shapeAny}
+	}
+
+	a.nullable = nullable
+	return a
+}
+
+// toType converts the shape to a Go type.
+func (s *shape) toType() reflect.Type {
+	var t reflect.Type
+	switch s.kind {
+	case shapeBool:
+		t = reflect.TypeOf(false)
+	case shapeInt:
+		t = reflect.TypeOf(int64(0))
+	case shapeFloat:
+		t = reflect.TypeOf(float64(0))
+	case shapeString:
+		t = reflect.TypeOf("")
+	case shapeTime:
+		t = reflect.TypeOf(time.Time{})
+	case shapeArray:
+		if s.elem == nil || s.elem.kind == shapeNull {
+			return reflect.SliceOf(anyType)
+		}
+
+		return reflect.SliceOf(s.elem.toType())
+	case shapeObject:
+		t = s.structType()
+	default:
+		return anyType
+	}
+
+	if s.nullable {
+		return reflect.PtrTo(t)
+	}
+
+	return t
+}
+
+// The anyType is the type used for values of unknown shape.
+var anyType = reflect.TypeOf((*interface{})(nil)).Elem()
+
+// structType converts the object shape to a struct type.
+func (s *shape) structType() reflect.Type {
+	used := make(map[string]bool, len(s.fields))
+	fields := make([]reflect.StructField, 0, len(s.fields))
+	for _, f := range s.fields {
+		name := goName(f.key)
+		for i := 2; used[name]; i++ {
+			name = goName(f.key) + strconv.Itoa(i)
+		}
+		used[name] = true
+
+		ft := anyType
+		if f.shape.kind != shapeNull {
+			ft = f.shape.toType()
+		}
+
+		fields = append(fields, reflect.StructField{
+			Name: name,
+			Type: ft,
+			Tag:  reflect.StructTag(fmt.Sprintf(`json:%q`, f.key)),
+		})
+	}
+
+	return reflect.StructOf(fields)
+}
+
+// goName converts the JSON key to an exported Go identifier,
+// e.g. "user_id" to "UserId".
+func goName(key string) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range key {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+
+		sb.WriteRune(r)
+	}
+
+	name := sb.String()
+	if name == "" || !unicode.IsLetter([]rune(name)[0]) {
+		name = "X" + name
+	}
+
+	return name
+}
+
+// isDate returns true if the string is a date in one of
+// the known layouts.
+func isDate(s string) bool {
+	for _, layout := range dateLayouts {
+		if _, err := time.Parse(layout, s); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isNumeric returns true if the shape kind is a number.
+func isNumeric(k shapeKind) bool {
+	return k == shapeInt || k == shapeFloat
+}
+
+// isTextual returns true if the shape kind is represented by a string.
+func isTextual(k shapeKind) bool {
+	return k == shapeString || k == shapeTime
+}
@@ -0,0 +1,147 @@
+package kindjson
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/goloop/kind"
+)
+
+// TestInferKind tests the kindjson.InferKind function.
+func TestInferKind(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		opts []Option
+		kind string
+	}{
+		{"bool", `true`, nil, "bool"},
+		{"string", `"text"`, nil, "string"},
+		{"int", `42`, nil, "int64"},
+		{"float", `4.2`, nil, "float64"},
+		{"exponent", `1e3`, nil, "float64"},
+		{"null", `null`, nil, "nil"},
+		{"ints", `[1, 2, 3]`, nil, "[]int64"},
+		{"numbers", `[1, 2.5]`, nil, "[]float64"},
+		{"nullable", `[1, null]`, nil, "[]*int64"},
+		{"mixed", `[1, "two"]`, nil, "[]interface {}"},
+		{"empty array", `[]`, nil, "[]interface {}"},
+		{"nested", `[[1], [2, 3]]`, nil, "[][]int64"},
+		{"date off", `"2023-08-01"`, nil, "string"},
+		{"date on", `"2023-08-01"`, []Option{WithDates()}, "time.Time"},
+		{
+			"object",
+			`{"id": 1, "user_name": "x"}`,
+			nil,
+			`struct { Id int64 "json:\"id\""; UserName string ` +
+				`"json:\"user_name\"" }`,
+		},
+		{
+			"objects",
+			`[{"a": 1}, {"a": 2.5, "b": null}]`,
+			nil,
+			`[]struct { A float64 "json:\"a\""; B interface {} ` +
+				`"json:\"b\"" }`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := InferKind([]byte(tt.data), tt.opts...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if k.Name() != tt.kind {
+				t.Errorf("Expected type name %s, but got %s",
+					tt.kind, k.Name())
+			}
+		})
+	}
+}
+
+// TestInferKindFlags tests the flags of inferred kinds.
+func TestInferKindFlags(t *testing.T) {
+	k, err := InferKind([]byte(`{"m": {"a": [1]}}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !k.IsStruct() {
+		t.Errorf("Expected struct kind, but got %s", k)
+	}
+
+	k, err = InferKind([]byte(`[[1, 2]]`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !k.IsSliceOfSlices() || !k.IsInt64() {
+		t.Errorf("Expected slice of slices of int64, but got %s", k)
+	}
+}
+
+// TestInferKindErrors tests the kindjson.InferKind function
+// for invalid documents.
+func TestInferKindErrors(t *testing.T) {
+	tests := []string{``, `{`, `[1,]`, `1 2`, `{"a" 1}`}
+	for _, data := range tests {
+		if _, err := InferKind([]byte(data)); err == nil {
+			t.Errorf("Expected error for %q", data)
+		}
+	}
+}
+
+// TestInferRaw tests the kindjson.InferRaw function.
+func TestInferRaw(t *testing.T) {
+	var msg struct{ Data, Empty json.RawMessage }
+	if err := json.Unmarshal([]byte(`{"Data": [1, 2]}`), &msg); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		raw  *kind.Kind
+		opts []Option
+		kind string
+	}{
+		{"decoded", kind.Of(msg.Data), nil, "[]int64"},
+		{"empty", kind.Of(msg.Empty), nil, "nil"},
+		{"null", kind.Of(json.RawMessage(`null`)), nil, "nil"},
+		{
+			"options",
+			kind.Of(json.RawMessage(`"2023-08-01"`)),
+			[]Option{WithDates()},
+			"time.Time",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := InferRaw(tt.raw, tt.opts...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if k.Name() != tt.kind {
+				t.Errorf("Expected %s, but got %s", tt.kind, k.Name())
+			}
+		})
+	}
+
+	errs := []*kind.Kind{
+		kind.Of([]byte(`[1]`)),
+		kind.For[json.RawMessage](),
+	}
+
+	for _, k := range errs {
+		if _, err := InferRaw(k); !errors.Is(err, ErrNotRawJSON) {
+			t.Errorf("Expected ErrNotRawJSON for %s, but got %v", k, err)
+		}
+	}
+
+	if _, err := InferRaw(kind.Of(json.RawMessage(`[1,`))); err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
@@ -0,0 +1,238 @@
+// Package kindopenapi converts Kind trees into OpenAPI 3.1 schema objects.
+//
+// Scalars map onto the JSON Schema types with format hints (int32, int64,
+// float, double), []byte becomes a base64 string and time.Time becomes
+// a string with the date-time format. Slices and arrays become arrays,
+// maps become objects with additionalProperties and structs become
+// objects with properties named after their json tags. Pointer kinds
+// are nullable, which OpenAPI 3.1 expresses by adding "null" to the type.
+//
+// Example usage:
+//
+//	type User struct {
+//		Name  string    `json:"name"`
+//		Email *string   `json:"email,omitempty"`
+//		Born  time.Time `json:"born"`
+//	}
+//
+//	schema := kindopenapi.SchemaOf(kind.Of(User{}))
+//	data, _ := json.Marshal(schema)
+//	fmt.Println(string(data))
+package kindopenapi
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/goloop/kind"
+)
+
+// Schema is an OpenAPI schema object ready to be encoded as JSON or YAML.
+type Schema map[string]interface{}
+
+// SchemaOf returns the OpenAPI schema object for the Kind instance.
+func SchemaOf(k *kind.Kind) Schema {
+	return schemaOf(k, make(map[string]bool))
+}
+
+// schemaOf returns the schema for the Kind instance, the seen contains
+// names of the structs being converted to stop on recursive types.
+// Pointers, slices and arrays are converted one element level at a time.
+func schemaOf(k *kind.Kind, seen map[string]bool) Schema {
+	if k.IsNil() {
+		return Schema{"type": "null"}
+	}
+
+	switch rk := k.ReflectKind(); rk {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		elem := k.ElemKind()
+		if elem.IsNil() {
+			// The Kind has no type, see flagSchema.
+			return flagSchema(k, seen)
+		}
+
+		// A []byte is encoded as a base64 string by encoding/json.
+		if rk == reflect.Slice && elem.ReflectKind() == reflect.Uint8 {
+			return Schema{"type": "string", "format": "byte"}
+		}
+
+		if rk == reflect.Ptr {
+			return nullable(schemaOf(elem, seen))
+		}
+
+		return Schema{"type": "array", "items": schemaOf(elem, seen)}
+	}
+
+	return baseSchema(k, seen)
+}
+
+// flagSchema returns the schema for the Kind instance restored without
+// its type, e.g. by kind.FromDescriptor, whose element levels are not
+// known, so the nesting is guessed from the sequence flags.
+func flagSchema(k *kind.Kind, seen map[string]bool) Schema {
+	if k.IsSlice() && k.IsUint8() && !k.IsPointer() {
+		return Schema{"type": "string", "format": "byte"}
+	}
+
+	s := baseSchema(k, seen)
+	if k.IsPointer() {
+		s = nullable(s)
+	}
+
+	depth := 0
+	switch {
+	case k.IsSliceOfSlices(), k.IsSliceOfArrays(),
+		k.IsArrayOfSlices(), k.IsArrayOfArrays():
+		depth = 2
+	case k.IsSlice(), k.IsArray():
+		depth = 1
+	}
+
+	for i := 0; i < depth; i++ {
+		s = Schema{"type": "array", "items": s}
+	}
+
+	return s
+}
+
+// baseSchema returns the schema of the element type of the Kind,
+// ignoring the sequence and pointer flags.
+func baseSchema(k *kind.Kind, seen map[string]bool) Schema {
+	switch {
+	case k.IsMap():
+		return Schema{
+			"type":                 "object",
+			"additionalProperties": schemaOf(k.MapValueKind(), seen),
+		}
+	case k.IsStruct():
+		return structSchema(k, seen)
+	case k.IsBool():
+		return Schema{"type": "boolean"}
+	case k.IsString():
+		return Schema{"type": "string"}
+	case k.IsInt8(), k.IsInt16(), k.IsInt32(), k.IsUint8(), k.IsUint16():
+		return Schema{"type": "integer", "format": "int32"}
+	case k.IsAnyInt(), k.IsUintptr():
+		return Schema{"type": "integer", "format": "int64"}
+	case k.IsFloat32():
+		return Schema{"type": "number", "format": "float"}
+	case k.IsFloat64():
+		return Schema{"type": "number", "format": "double"}
+	}
+
+	// Interfaces, channels, functions and complex numbers
+	// have no JSON representation, any value is accepted.
+	return Schema{}
+}
+
+// structSchema returns the object schema for the struct Kind.
+func structSchema(k *kind.Kind, seen map[string]bool) Schema {
+	name := baseName(k.Name())
+	if name == "time.Time" {
+		return Schema{"type": "string", "format": "date-time"}
+	}
+
+	if seen[name] {
+		return Schema{"type": "object"}
+	}
+
+	seen[name] = true
+	defer delete(seen, name)
+
+	properties := Schema{}
+	required := []string{}
+	addProperties(k, properties, &required, seen)
+
+	s := Schema{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+
+	return s
+}
+
+// addProperties adds the exported fields of the struct Kind to
+// the properties, the fields of embedded structs are promoted
+// as encoding/json does.
+func addProperties(
+	k *kind.Kind,
+	properties Schema,
+	required *[]string,
+	seen map[string]bool,
+) {
+	for _, f := range k.Fields() {
+		name, omitempty, skip := jsonName(f)
+		if skip {
+			continue
+		}
+
+		if f.Embedded && name == "" && f.Kind.IsStruct() &&
+			!f.Kind.IsSlice() && !f.Kind.IsArray() {
+			addProperties(f.Kind, properties, required, seen)
+			continue
+		}
+
+		if !f.Exported {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		properties[name] = schemaOf(f.Kind, seen)
+		if !omitempty && !f.Kind.IsPointer() {
+			*required = append(*required, name)
+		}
+	}
+}
+
+// jsonName returns the name from the json tag of the field,
+// whether it has the omitempty option and whether the field
+// must be skipped.
+func jsonName(f kind.Field) (string, bool, bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+
+	name, opts, _ := strings.Cut(tag, ",")
+	omitempty := false
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "omitempty" {
+			omitempty = true
+		}
+	}
+
+	return name, omitempty, false
+}
+
+// nullable returns the schema that also accepts null.
+// The schema without type already accepts null as is.
+func nullable(s Schema) Schema {
+	if t, ok := s["type"].(string); ok {
+		s["type"] = []string{t, "null"}
+	}
+
+	return s
+}
+
+// baseName returns the type name without pointer,
+// slice, array and channel prefixes.
+func baseName(name string) string {
+	for {
+		switch {
+		case strings.HasPrefix(name, "*"):
+			name = name[1:]
+		case strings.HasPrefix(name, "[]"):
+			name = name[2:]
+		case strings.HasPrefix(name, "chan "):
+			name = name[5:]
+		case strings.HasPrefix(name, "["):
+			i := strings.Index(name, "]")
+			name = name[i+1:]
+		default:
+			return name
+		}
+	}
+}
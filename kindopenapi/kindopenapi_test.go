@@ -0,0 +1,144 @@
+package kindopenapi
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/goloop/kind"
+)
+
+// asJSON returns the JSON encoding of the schema.
+func asJSON(t *testing.T, s Schema) string {
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	return string(data)
+}
+
+// TestSchema tests the kindopenapi.SchemaOf function.
+func TestSchema(t *testing.T) {
+	n := 1
+	untyped := kind.Of([]string{}).Descriptor()
+	untyped.Type = ""
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected string
+	}{
+		{"nil", nil, `{"type":"null"}`},
+		{"bool", true, `{"type":"boolean"}`},
+		{"string", "test", `{"type":"string"}`},
+		{"int8", int8(1), `{"format":"int32","type":"integer"}`},
+		{"int", 1, `{"format":"int64","type":"integer"}`},
+		{"float32", float32(1), `{"format":"float","type":"number"}`},
+		{"float64", 1.0, `{"format":"double","type":"number"}`},
+		{"bytes", []byte("x"), `{"format":"byte","type":"string"}`},
+		{"complex", complex64(1), `{}`},
+		{"pointer", &n, `{"format":"int64","type":["integer","null"]}`},
+		{
+			"time",
+			time.Time{},
+			`{"format":"date-time","type":"string"}`,
+		},
+		{
+			"slice",
+			[]string{},
+			`{"items":{"type":"string"},"type":"array"}`,
+		},
+		{
+			"slice of slices",
+			[][]bool{},
+			`{"items":{"items":{"type":"boolean"},"type":"array"},` +
+				`"type":"array"}`,
+		},
+		{
+			"three levels",
+			[][][]int{},
+			`{"items":{"items":{"items":{"format":"int64","type":"integer"},` +
+				`"type":"array"},"type":"array"},"type":"array"}`,
+		},
+		{
+			"slice of pointers to slices",
+			[]*[]int{},
+			`{"items":{"items":{"format":"int64","type":"integer"},` +
+				`"type":["array","null"]},"type":"array"}`,
+		},
+		{
+			"pointer to slice",
+			&[]int{},
+			`{"items":{"format":"int64","type":"integer"},` +
+				`"type":["array","null"]}`,
+		},
+		{
+			"slice of bytes",
+			[][]byte{},
+			`{"items":{"format":"byte","type":"string"},"type":"array"}`,
+		},
+		{
+			"array of bytes",
+			[2]byte{},
+			`{"items":{"format":"int32","type":"integer"},"type":"array"}`,
+		},
+		{
+			"without type",
+			kind.FromDescriptor(untyped),
+			`{"items":{"type":"string"},"type":"array"}`,
+		},
+		{
+			"map",
+			map[string]int{},
+			`{"additionalProperties":{"format":"int64","type":"integer"},` +
+				`"type":"object"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, ok := tt.input.(*kind.Kind)
+			if !ok {
+				k = kind.Of(tt.input)
+			}
+
+			result := asJSON(t, SchemaOf(k))
+			if result != tt.expected {
+				t.Errorf("Expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+// TestSchemaStruct tests the kindopenapi.SchemaOf function for structs.
+func TestSchemaStruct(t *testing.T) {
+	type Base struct {
+		ID int `json:"id"`
+	}
+
+	type Node struct {
+		Base
+		Name    string    `json:"name"`
+		Email   *string   `json:"email"`
+		Note    string    `json:"note,omitempty"`
+		Created time.Time `json:"created"`
+		Next    *Node     `json:"next"`
+		Skip    string    `json:"-"`
+		hidden  string
+	}
+
+	expected := `{"properties":{` +
+		`"created":{"format":"date-time","type":"string"},` +
+		`"email":{"type":["string","null"]},` +
+		`"id":{"format":"int64","type":"integer"},` +
+		`"name":{"type":"string"},` +
+		`"next":{"type":["object","null"]},` +
+		`"note":{"type":"string"}},` +
+		`"required":["id","name","created"],` +
+		`"type":"object"}`
+
+	result := asJSON(t, SchemaOf(kind.Of(Node{})))
+	if result != expected {
+		t.Errorf("Expected %s, but got %s", expected, result)
+	}
+}
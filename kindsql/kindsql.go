@@ -0,0 +1,255 @@
+// Package kindsql maps Kind trees onto SQL column types and generates
+// CREATE TABLE statements for struct kinds.
+//
+// Example usage:
+//
+//	type User struct {
+//		ID      int64     `db:"id"`
+//		Name    string    `db:"name"`
+//		Email   *string   `db:"email"`
+//		Created time.Time `db:"created_at"`
+//	}
+//
+//	ddl, err := kindsql.CreateTable("users", kind.Of(User{}), kindsql.Postgres)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(ddl)
+//	// CREATE TABLE "users" (
+//	// 	"id" BIGINT NOT NULL,
+//	// 	"name" TEXT NOT NULL,
+//	// 	"email" TEXT,
+//	// 	"created_at" TIMESTAMP WITH TIME ZONE NOT NULL
+//	// );
+package kindsql
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/goloop/kind"
+)
+
+var (
+	// ErrUnsupportedKind is returned when the kind has
+	// no SQL column representation.
+	ErrUnsupportedKind = errors.New("kindsql: unsupported kind")
+
+	// ErrNotStruct is returned when a table is generated
+	// for a kind that is not a struct.
+	ErrNotStruct = errors.New("kindsql: kind is not a struct")
+)
+
+// Dialect is an SQL dialect.
+type Dialect int
+
+const (
+	// Postgres is the PostgreSQL dialect.
+	Postgres Dialect = iota
+
+	// MySQL is the MySQL dialect.
+	MySQL
+
+	// SQLite is the SQLite dialect.
+	SQLite
+)
+
+// String returns the name of the dialect.
+func (d Dialect) String() string {
+	switch d {
+	case Postgres:
+		return "postgres"
+	case MySQL:
+		return "mysql"
+	case SQLite:
+		return "sqlite"
+	}
+
+	return fmt.Sprintf("dialect(%d)", int(d))
+}
+
+// The types is a table of column types per dialect,
+// indexed by Dialect.
+type types [3]string
+
+var (
+	typeBool      = types{"BOOLEAN", "BOOLEAN", "INTEGER"}
+	typeSmallInt  = types{"SMALLINT", "SMALLINT", "INTEGER"}
+	typeInteger   = types{"INTEGER", "INTEGER", "INTEGER"}
+	typeBigInt    = types{"BIGINT", "BIGINT", "INTEGER"}
+	typeReal      = types{"REAL", "FLOAT", "REAL"}
+	typeDouble    = types{"DOUBLE PRECISION", "DOUBLE", "REAL"}
+	typeText      = types{"TEXT", "TEXT", "TEXT"}
+	typeBlob      = types{"BYTEA", "BLOB", "BLOB"}
+	typeTimestamp = types{"TIMESTAMP WITH TIME ZONE", "DATETIME", "DATETIME"}
+	typeJSON      = types{"JSONB", "JSON", "TEXT"}
+)
+
+// ColumnType returns the SQL column type for the Kind instance.
+// Pointers are mapped to the type of their element, the nullability
+// is expressed by the column constraints, see CreateTable.
+//
+// Example usage:
+//
+//	t, _ := kindsql.ColumnType(kind.Of(int64(1)), kindsql.Postgres)
+//	fmt.Println(t) // "BIGINT"
+func ColumnType(k *kind.Kind, dialect Dialect) (string, error) {
+	if dialect < Postgres || dialect > SQLite {
+		return "", fmt.Errorf("kindsql: unknown %s", dialect)
+	}
+
+	var t types
+	switch {
+	case k.IsChannel(), k.IsFunction(), k.IsAnyComplex():
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedKind, k)
+	case isBytes(k):
+		t = typeBlob
+	case k.IsMap(), k.IsSlice(), k.IsArray(),
+		k.IsSliceOfSlices(), k.IsSliceOfArrays(),
+		k.IsArrayOfSlices(), k.IsArrayOfArrays():
+		t = typeJSON
+	case k.IsStruct():
+		t = typeJSON
+		if strings.TrimLeft(k.Name(), "*") == "time.Time" {
+			t = typeTimestamp
+		}
+	case k.IsBool():
+		t = typeBool
+	case k.IsString():
+		t = typeText
+	case k.IsInt8(), k.IsInt16(), k.IsUint8():
+		t = typeSmallInt
+	case k.IsInt32(), k.IsUint16():
+		t = typeInteger
+	case k.IsAnyInt():
+		t = typeBigInt
+	case k.IsFloat32():
+		t = typeReal
+	case k.IsFloat64():
+		t = typeDouble
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedKind, k)
+	}
+
+	return t[dialect], nil
+}
+
+// isBytes returns true if the Kind is a byte slice or a pointer to it,
+// e.g. *[]byte, which is mapped onto a nullable BLOB column.
+func isBytes(k *kind.Kind) bool {
+	if k.ReflectKind() == reflect.Ptr && !k.ElemKind().IsNil() {
+		k = k.ElemKind()
+	}
+
+	if elem := k.ElemKind(); !elem.IsNil() {
+		return k.ReflectKind() == reflect.Slice &&
+			elem.ReflectKind() == reflect.Uint8
+	}
+
+	// The Kind has no type, so only a plain byte slice is recognized.
+	return k.IsSlice() && k.IsUint8() && !k.IsPointer()
+}
+
+// CreateTable returns the CREATE TABLE statement for the struct Kind.
+// Column names are taken from the db tags of exported fields, a field
+// with the "-" tag is skipped and a field without a tag is named by
+// converting its name to snake case. Fields of embedded structs without
+// a db tag are promoted. Pointer fields are nullable, all other columns
+// are NOT NULL.
+func CreateTable(table string, k *kind.Kind, dialect Dialect) (string, error) {
+	if !k.IsStruct() || k.IsSlice() || k.IsArray() || k.IsMap() {
+		return "", fmt.Errorf("%w: %s", ErrNotStruct, k)
+	}
+
+	var columns []string
+	if err := addColumns(k, dialect, &columns); err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("CREATE TABLE ")
+	sb.WriteString(quote(table, dialect))
+	sb.WriteString(" (\n\t")
+	sb.WriteString(strings.Join(columns, ",\n\t"))
+	sb.WriteString("\n);")
+
+	return sb.String(), nil
+}
+
+// addColumns appends the column definitions for the fields
+// of the struct Kind to the columns.
+func addColumns(k *kind.Kind, dialect Dialect, columns *[]string) error {
+	for _, f := range k.Fields() {
+		name := f.Tag.Get("db")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" && f.Embedded && f.Kind.IsStruct() &&
+			!f.Kind.IsSlice() && !f.Kind.IsArray() {
+			if err := addColumns(f.Kind, dialect, columns); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if !f.Exported {
+			continue
+		}
+
+		if name == "" {
+			name = snakeCase(f.Name)
+		}
+
+		t, err := ColumnType(f.Kind, dialect)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.Name, err)
+		}
+
+		column := quote(name, dialect) + " " + t
+		if !f.Kind.IsPointer() {
+			column += " NOT NULL"
+		}
+
+		*columns = append(*columns, column)
+	}
+
+	return nil
+}
+
+// quote quotes the identifier for the dialect.
+func quote(name string, dialect Dialect) string {
+	if dialect == MySQL {
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// snakeCase converts the Go identifier to snake case,
+// e.g. "UserID" to "user_id".
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev, next := runes[i-1], rune(0)
+			if i+1 < len(runes) {
+				next = runes[i+1]
+			}
+
+			if !unicode.IsUpper(prev) || unicode.IsLower(next) {
+				sb.WriteByte('_')
+			}
+		}
+
+		sb.WriteRune(unicode.ToLower(r))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,130 @@
+package kindsql
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goloop/kind"
+)
+
+// TestColumnType tests the kindsql.ColumnType function.
+func TestColumnType(t *testing.T) {
+	s := "text"
+	b := []byte("x")
+	x := byte('x')
+	tests := []struct {
+		name     string
+		input    interface{}
+		dialect  Dialect
+		expected string
+	}{
+		{"bool", true, Postgres, "BOOLEAN"},
+		{"bool sqlite", true, SQLite, "INTEGER"},
+		{"int16", int16(1), Postgres, "SMALLINT"},
+		{"int32", int32(1), MySQL, "INTEGER"},
+		{"int64", int64(1), Postgres, "BIGINT"},
+		{"uint", uint(1), Postgres, "BIGINT"},
+		{"float32", float32(1), Postgres, "REAL"},
+		{"float64", 1.0, Postgres, "DOUBLE PRECISION"},
+		{"float64 mysql", 1.0, MySQL, "DOUBLE"},
+		{"string", "x", Postgres, "TEXT"},
+		{"pointer", &s, Postgres, "TEXT"},
+		{"bytes", []byte("x"), Postgres, "BYTEA"},
+		{"pointer to bytes", &b, Postgres, "BYTEA"},
+		{"pointer to bytes mysql", &b, MySQL, "BLOB"},
+		{"slice of pointers to bytes", []*byte{&x}, Postgres, "JSONB"},
+		{"time", time.Time{}, Postgres, "TIMESTAMP WITH TIME ZONE"},
+		{"time mysql", time.Time{}, MySQL, "DATETIME"},
+		{"map", map[string]int{}, Postgres, "JSONB"},
+		{"slice", []int{}, MySQL, "JSON"},
+		{"struct", struct{ A int }{}, SQLite, "TEXT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ColumnType(kind.Of(tt.input), tt.dialect)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result != tt.expected {
+				t.Errorf("Expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+// TestColumnTypeErrors tests the kindsql.ColumnType function
+// for unsupported kinds and dialects.
+func TestColumnTypeErrors(t *testing.T) {
+	_, err := ColumnType(kind.Of(make(chan int)), Postgres)
+	if !errors.Is(err, ErrUnsupportedKind) {
+		t.Errorf("Expected ErrUnsupportedKind, but got %v", err)
+	}
+
+	if _, err := ColumnType(kind.Of(1), Dialect(10)); err == nil {
+		t.Error("Expected error for unknown dialect")
+	}
+}
+
+// TestCreateTable tests the kindsql.CreateTable function.
+func TestCreateTable(t *testing.T) {
+	type Base struct {
+		ID int64 `db:"id"`
+	}
+
+	type User struct {
+		Base
+		UserName string
+		Email    *string   `db:"email"`
+		Created  time.Time `db:"created_at"`
+		Avatar   *[]byte   `db:"avatar"`
+		Skip     string    `db:"-"`
+		hidden   string
+	}
+
+	expected := "CREATE TABLE `users` (\n" +
+		"\t`id` BIGINT NOT NULL,\n" +
+		"\t`user_name` TEXT NOT NULL,\n" +
+		"\t`email` TEXT,\n" +
+		"\t`created_at` DATETIME NOT NULL,\n" +
+		"\t`avatar` BLOB\n" +
+		");"
+
+	result, err := CreateTable("users", kind.Of(User{}), MySQL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result != expected {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, result)
+	}
+
+	_, err = CreateTable("t", kind.Of([]User{}), Postgres)
+	if !errors.Is(err, ErrNotStruct) {
+		t.Errorf("Expected ErrNotStruct, but got %v", err)
+	}
+
+	_, err = CreateTable("t", kind.Of(struct{ C chan int }{}), Postgres)
+	if !errors.Is(err, ErrUnsupportedKind) {
+		t.Errorf("Expected ErrUnsupportedKind, but got %v", err)
+	}
+}
+
+// TestSnakeCase tests the snakeCase function.
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"ID":         "id",
+		"UserID":     "user_id",
+		"HTTPServer": "http_server",
+		"Name":       "name",
+		"createdAt":  "created_at",
+	}
+
+	for input, expected := range tests {
+		if result := snakeCase(input); result != expected {
+			t.Errorf("Expected %s, but got %s", expected, result)
+		}
+	}
+}
@@ -0,0 +1,353 @@
+// Package kindts generates TypeScript declarations from Kind trees.
+//
+// Named structs become exported interfaces with properties named after
+// their json tags, maps become Record types, slices and arrays become
+// array types and pointer fields become optional properties. Numbers
+// of any size map onto number, while []byte and time.Time map onto
+// string, following their encoding/json representation.
+//
+// Example usage:
+//
+//	type User struct {
+//		Name  string         `json:"name"`
+//		Email *string        `json:"email"`
+//		Tags  []string       `json:"tags"`
+//		Meta  map[string]int `json:"meta"`
+//	}
+//
+//	ts, _ := kindts.Generate(kind.Of(User{}))
+//	fmt.Println(ts)
+//	// export interface User {
+//	//   name: string;
+//	//   email?: string;
+//	//   tags: string[];
+//	//   meta: Record<string, number>;
+//	// }
+package kindts
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/goloop/kind"
+)
+
+// ErrNoDeclarations is returned when the kind has no named
+// types to declare.
+var ErrNoDeclarations = errors.New("kindts: no named types to declare")
+
+// The indent is the indentation used for interface properties.
+const indent = "  "
+
+// The generator collects declarations of named types.
+type generator struct {
+	decls []string          // declarations in discovery order
+	names map[string]string // declared names by full type names
+	used  map[string]bool   // declared names
+}
+
+// Generate returns TypeScript declarations for the Kind instance and
+// all named struct types it refers to. The declaration of the Kind
+// itself comes first when it has a name.
+func Generate(k *kind.Kind) (string, error) {
+	g := &generator{names: make(map[string]string), used: make(map[string]bool)}
+
+	if name, ok := declName(k.Name()); ok && !isStruct(k) {
+		// Reserve the slot to keep the root declaration first.
+		g.decls = append(g.decls, "")
+		name, _ = g.declare(k, name)
+		g.decls[0] = fmt.Sprintf("export type %s = %s;", name, g.expr(k))
+	} else {
+		g.expr(k)
+	}
+
+	if len(g.decls) == 0 {
+		return "", fmt.Errorf("%w: %s", ErrNoDeclarations, k)
+	}
+
+	return strings.Join(g.decls, "\n\n") + "\n", nil
+}
+
+// expr returns the TypeScript type expression for the Kind instance.
+// Pointers, slices and arrays are converted one element level at a time.
+func (g *generator) expr(k *kind.Kind) string {
+	if k.IsNil() {
+		return "null"
+	}
+
+	switch rk := k.ReflectKind(); rk {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		elem := k.ElemKind()
+		if elem.IsNil() {
+			// The Kind has no type, see flagExpr.
+			return g.flagExpr(k)
+		}
+
+		// A []byte is encoded as a base64 string by encoding/json.
+		if rk == reflect.Slice && elem.ReflectKind() == reflect.Uint8 {
+			return "string"
+		}
+
+		s := g.expr(elem)
+		if rk == reflect.Ptr {
+			if strings.HasSuffix(s, " | null") {
+				return s
+			}
+
+			return s + " | null"
+		}
+
+		return arrayOf(s, 1)
+	}
+
+	return g.base(k)
+}
+
+// flagExpr returns the type expression for the Kind instance restored
+// without its type, e.g. by kind.FromDescriptor. Its element levels are
+// not known, so the nesting is guessed from the sequence flags, and the
+// leading "*" of the name tells a nullable sequence from a sequence of
+// nullable elements.
+func (g *generator) flagExpr(k *kind.Kind) string {
+	if k.IsSlice() && k.IsUint8() && !k.IsPointer() {
+		return "string"
+	}
+
+	outer := strings.HasPrefix(k.Name(), "*")
+	s := g.base(k)
+	if k.IsPointer() && !outer {
+		s += " | null"
+	}
+
+	depth := 0
+	switch {
+	case k.IsSliceOfSlices(), k.IsSliceOfArrays(),
+		k.IsArrayOfSlices(), k.IsArrayOfArrays():
+		depth = 2
+	case k.IsSlice(), k.IsArray():
+		depth = 1
+	}
+
+	s = arrayOf(s, depth)
+	if outer {
+		s += " | null"
+	}
+
+	return s
+}
+
+// arrayOf returns the type expression of depth nested arrays of the
+// element expression, which is parenthesized if it is compound.
+func arrayOf(s string, depth int) string {
+	if depth == 0 {
+		return s
+	}
+
+	if strings.Contains(s, " ") &&
+		(!strings.HasPrefix(s, "{") || strings.HasSuffix(s, " | null")) {
+		s = "(" + s + ")"
+	}
+
+	return s + strings.Repeat("[]", depth)
+}
+
+// base returns the type expression of the element type of the Kind,
+// ignoring the sequence and pointer flags.
+func (g *generator) base(k *kind.Kind) string {
+	switch {
+	case k.IsChannel(), k.IsFunction(), k.IsAnyComplex():
+		return "unknown"
+	case k.IsMap():
+		key := "string"
+		if k.MapKeyKind().IsNumber() {
+			key = "number"
+		}
+
+		return fmt.Sprintf("Record<%s, %s>", key, g.expr(k.MapValueKind()))
+	case k.IsStruct():
+		return g.object(k)
+	case k.IsBool():
+		return "boolean"
+	case k.IsString():
+		return "string"
+	case k.IsNumber(), k.IsUintptr():
+		return "number"
+	}
+
+	return "unknown"
+}
+
+// object returns the type expression for the struct Kind, named structs
+// are declared as interfaces and referenced by name.
+func (g *generator) object(k *kind.Kind) string {
+	elem := elemName(k.Name())
+	if elem == "time.Time" {
+		return "string"
+	}
+
+	name, ok := declName(elem)
+	if !ok {
+		return "{ " + strings.Join(g.properties(k), " ") + " }"
+	}
+
+	if name, ok = g.declare(k, name); ok {
+		i := len(g.decls)
+		g.decls = append(g.decls, "")
+
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "export interface %s {\n", name)
+		for _, p := range g.properties(k) {
+			sb.WriteString(indent + p + "\n")
+		}
+		sb.WriteString("}")
+		g.decls[i] = sb.String()
+	}
+
+	return name
+}
+
+// declare returns the name to declare the named Kind with, and true if
+// the type is not declared yet. The types are told apart by their full
+// names with the package paths, and a type whose short name is taken by
+// a type of another package is declared with the package name prefix,
+// e.g. "BillingUser".
+func (g *generator) declare(k *kind.Kind, short string) (string, bool) {
+	full := elemName(k.Descriptor().Type)
+	if full == "" {
+		full = elemName(k.Name())
+	}
+
+	if name, ok := g.names[full]; ok {
+		return name, false
+	}
+
+	name := short
+	if g.used[name] {
+		name = packagePrefix(full) + short
+		for i := 2; g.used[name]; i++ {
+			name = fmt.Sprintf("%s%s%d", packagePrefix(full), short, i)
+		}
+	}
+
+	g.names[full] = name
+	g.used[name] = true
+
+	return name, true
+}
+
+// packagePrefix returns the last element of the package path of the
+// full type name, e.g. "Billing" for "example.com/billing.User",
+// without the characters not allowed in identifiers.
+func packagePrefix(full string) string {
+	path := full[:strings.LastIndex(full, ".")+1]
+	path = strings.TrimSuffix(path, ".")
+	path = path[strings.LastIndex(path, "/")+1:]
+
+	var sb strings.Builder
+	for _, r := range path {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') ||
+			(sb.Len() > 0 && r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		}
+	}
+
+	prefix := sb.String()
+	if prefix == "" {
+		return ""
+	}
+
+	return strings.ToUpper(prefix[:1]) + prefix[1:]
+}
+
+// properties returns the property signatures for the exported fields
+// of the struct Kind, the fields of embedded structs are promoted as
+// encoding/json does.
+func (g *generator) properties(k *kind.Kind) []string {
+	var result []string
+	for _, f := range k.Fields() {
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" && f.Embedded && isStruct(f.Kind) {
+			result = append(result, g.properties(f.Kind)...)
+			continue
+		}
+
+		if !f.Exported {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		pointer := strings.HasPrefix(f.Kind.Name(), "*")
+		optional := ""
+		if pointer || strings.Contains(","+opts+",", ",omitempty,") {
+			optional = "?"
+		}
+
+		expr := g.expr(f.Kind)
+		if pointer {
+			expr = strings.TrimSuffix(expr, " | null")
+		}
+
+		result = append(result, fmt.Sprintf("%s%s: %s;", name, optional, expr))
+	}
+
+	return result
+}
+
+// isStruct returns true if the Kind is a struct or a pointer
+// to a struct, but not a container of structs.
+func isStruct(k *kind.Kind) bool {
+	return k.IsStruct() && !k.IsSlice() && !k.IsArray() &&
+		!k.IsSliceOfSlices() && !k.IsSliceOfArrays() &&
+		!k.IsArrayOfSlices() && !k.IsArrayOfArrays() &&
+		!k.IsChannel()
+}
+
+// declName returns the name to declare the type with. Only defined
+// types have names, e.g. "*pkg.User" is declared as "User", while
+// "[]pkg.User" and "struct { A int }" have no names.
+func declName(name string) (string, bool) {
+	name = strings.TrimLeft(name, "*")
+	i := strings.LastIndex(name, ".")
+	if i < 0 || strings.ContainsAny(name, "[]{}() ") {
+		return "", false
+	}
+
+	name = name[i+1:]
+	for j, r := range name {
+		letter := r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		if !letter && (j == 0 || r < '0' || r > '9') {
+			return "", false
+		}
+	}
+
+	return name, true
+}
+
+// elemName returns the type name without pointer, slice,
+// array and channel prefixes.
+func elemName(name string) string {
+	for {
+		switch {
+		case strings.HasPrefix(name, "*"):
+			name = name[1:]
+		case strings.HasPrefix(name, "[]"):
+			name = name[2:]
+		case strings.HasPrefix(name, "chan "):
+			name = name[5:]
+		case strings.HasPrefix(name, "["):
+			name = name[strings.Index(name, "]")+1:]
+		default:
+			return name
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package kindts
+
+import (
+	"errors"
+	"net/url"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/goloop/kind"
+)
+
+// IDs is a named slice type used in tests.
+type IDs []int64
+
+// Address is a nested struct type used in tests.
+type Address struct {
+	City string `json:"city"`
+}
+
+// User is a struct type used in tests.
+type User struct {
+	Name     string            `json:"name"`
+	Email    *string           `json:"email"`
+	Note     string            `json:"note,omitempty"`
+	Tags     []string          `json:"tags"`
+	Scores   map[string]int    `json:"scores"`
+	Created  time.Time         `json:"created"`
+	Address  Address           `json:"address"`
+	Friends  []*User           `json:"friends"`
+	Extra    struct{ A bool }  `json:"extra"`
+	Raw      []byte            `json:"raw"`
+	ByID     map[int][]float64 `json:"by_id"`
+	Skip     string            `json:"-"`
+	internal string
+}
+
+// Nested is a struct type with nested sequences used in tests.
+type Nested struct {
+	Cube    [][][]int `json:"cube"`
+	Rows    []*[]int  `json:"rows"`
+	Row     *[]int    `json:"row"`
+	Blobs   [][]byte  `json:"blobs"`
+	Objects []*struct {
+		A bool
+	} `json:"objects"`
+}
+
+// Failures is a struct type referring to the types with
+// the same name from different packages used in tests.
+type Failures struct {
+	Exec  exec.Error  `json:"exec"`
+	URL   url.Error   `json:"url"`
+	Again *exec.Error `json:"again"`
+}
+
+// TestGenerate tests the kindts.Generate function for structs.
+func TestGenerate(t *testing.T) {
+	expected := "export interface User {\n" +
+		"  name: string;\n" +
+		"  email?: string;\n" +
+		"  note?: string;\n" +
+		"  tags: string[];\n" +
+		"  scores: Record<string, number>;\n" +
+		"  created: string;\n" +
+		"  address: Address;\n" +
+		"  friends: (User | null)[];\n" +
+		"  extra: { A: boolean; };\n" +
+		"  raw: string;\n" +
+		"  by_id: Record<number, number[]>;\n" +
+		"}\n\n" +
+		"export interface Address {\n" +
+		"  city: string;\n" +
+		"}\n"
+
+	result, err := Generate(kind.Of(User{}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result != expected {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, result)
+	}
+}
+
+// TestGenerateTypes tests the kindts.Generate function for named
+// non-struct types and containers of structs.
+func TestGenerateTypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected string
+	}{
+		{"named slice", IDs{}, "export type IDs = number[];\n"},
+		{
+			"nested sequences",
+			Nested{},
+			"export interface Nested {\n" +
+				"  cube: number[][][];\n" +
+				"  rows: (number[] | null)[];\n" +
+				"  row?: number[];\n" +
+				"  blobs: string[];\n" +
+				"  objects: ({ A: boolean; } | null)[];\n" +
+				"}\n",
+		},
+		{
+			"same names",
+			Failures{},
+			"export interface Failures {\n" +
+				"  exec: Error;\n" +
+				"  url: UrlError;\n" +
+				"  again?: Error;\n" +
+				"}\n\n" +
+				"export interface Error {\n" +
+				"  Name: string;\n" +
+				"  Err: unknown;\n" +
+				"}\n\n" +
+				"export interface UrlError {\n" +
+				"  Op: string;\n" +
+				"  URL: string;\n" +
+				"  Err: unknown;\n" +
+				"}\n",
+		},
+		{
+			"slice of structs",
+			[]Address{},
+			"export interface Address {\n  city: string;\n}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Generate(kind.Of(tt.input))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result != tt.expected {
+				t.Errorf("Expected:\n%s\nbut got:\n%s", tt.expected, result)
+			}
+		})
+	}
+
+	_, err := Generate(kind.Of([]int{}))
+	if !errors.Is(err, ErrNoDeclarations) {
+		t.Errorf("Expected ErrNoDeclarations, but got %v", err)
+	}
+}
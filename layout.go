@@ -0,0 +1,145 @@
+package kind
+
+import "sort"
+
+// Layout describes the memory layout of a struct type.
+type Layout struct {
+	Size    uintptr       // size of the struct in bytes
+	Align   uintptr       // alignment of the struct in bytes
+	Padding uintptr       // bytes wasted on padding
+	Fields  []FieldLayout // fields in declaration order
+
+	// Suggested is the field order with the least padding,
+	// and SuggestedSize is the size of the struct in that order.
+	Suggested     []string
+	SuggestedSize uintptr
+}
+
+// FieldLayout describes the place of a field in the struct layout.
+type FieldLayout struct {
+	Name    string  // name of the field
+	Offset  uintptr // offset of the field in the struct
+	Size    uintptr // size of the field in bytes
+	Align   uintptr // alignment of the field in bytes
+	Padding uintptr // bytes of padding after the field
+}
+
+// Layout returns the memory layout of the struct represented by the Kind
+// instance: the size, the offset and padding of each field, and a field
+// order that minimizes the padding. Like Fields, it describes the element
+// struct of a pointer, slice or array. It returns false if the Kind
+// instance does not represent a struct.
+//
+// Example usage:
+//
+//	type Flags struct {
+//		Enabled bool
+//		ID      int64
+//		Visible bool
+//	}
+//
+//	l, _ := kind.Of(Flags{}).Layout()
+//	fmt.Println(l.Size, l.Padding) // 24 14
+//	fmt.Println(l.Suggested, l.SuggestedSize) // [ID Enabled Visible] 16
+func (k *Kind) Layout() (Layout, bool) {
+	t := k.structType()
+	if t == nil {
+		return Layout{}, false
+	}
+
+	l := Layout{
+		Size:   t.Size(),
+		Align:  uintptr(t.Align()),
+		Fields: make([]FieldLayout, t.NumField()),
+	}
+
+	used := uintptr(0)
+	for i := range l.Fields {
+		sf := t.Field(i)
+		end := t.Size()
+		if i+1 < t.NumField() {
+			end = t.Field(i + 1).Offset
+		}
+
+		l.Fields[i] = FieldLayout{
+			Name:    sf.Name,
+			Offset:  sf.Offset,
+			Size:    sf.Type.Size(),
+			Align:   uintptr(sf.Type.Align()),
+			Padding: end - sf.Offset - sf.Type.Size(),
+		}
+		used += sf.Type.Size()
+	}
+
+	l.Padding = l.Size - used
+
+	order := optimalOrder(l.Fields)
+	l.Suggested = make([]string, len(order))
+	for i, f := range order {
+		l.Suggested[i] = f.Name
+	}
+
+	l.SuggestedSize = structSize(order, l.Align)
+	return l, true
+}
+
+// OptimalFieldOrder returns the names of the fields of the struct
+// represented by the Kind instance in the order that minimizes the size
+// of the struct, for code generators that emit packed structs. Fields
+// with the same alignment keep their declaration order. It returns nil
+// if the Kind instance does not represent a struct.
+//
+// Example usage:
+//
+//	order := kind.OptimalFieldOrder(kind.Of(Flags{}))
+//	fmt.Println(order) // [ID Enabled Visible]
+func OptimalFieldOrder(k *Kind) []string {
+	l, ok := k.Layout()
+	if !ok {
+		return nil
+	}
+
+	return l.Suggested
+}
+
+// optimalOrder returns the fields in the order with the least padding.
+// Field sizes are multiples of their alignments, so ordering by
+// decreasing alignment leaves no padding between the fields. The
+// zero-size fields go first, because a zero-size last field is padded
+// so that its address does not point past the struct.
+func optimalOrder(fields []FieldLayout) []FieldLayout {
+	order := append([]FieldLayout(nil), fields...)
+	sort.SliceStable(order, func(i, j int) bool {
+		if (order[i].Size == 0) != (order[j].Size == 0) {
+			return order[i].Size == 0
+		}
+
+		return order[i].Align > order[j].Align
+	})
+
+	return order
+}
+
+// structSize returns the size of a struct with the fields in the order,
+// following the layout rules of the gc compiler.
+func structSize(fields []FieldLayout, align uintptr) uintptr {
+	size := uintptr(0)
+	for _, f := range fields {
+		size = alignUp(size, f.Align) + f.Size
+	}
+
+	if n := len(fields); n > 0 && fields[n-1].Size == 0 && size > 0 {
+		size++
+	}
+
+	return alignUp(size, align)
+}
+
+// alignUp rounds n up to a multiple of align.
+func alignUp(n, align uintptr) uintptr {
+	if align == 0 {
+		return n
+	}
+
+	return (n + align - 1) / align * align
+}
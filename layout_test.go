@@ -0,0 +1,124 @@
+package kind
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestLayout tests the Layout method.
+func TestLayout(t *testing.T) {
+	type Flags struct {
+		Enabled bool
+		ID      int64
+		Visible bool
+	}
+
+	type Packed struct {
+		ID   int64
+		N    int32
+		Flag bool
+	}
+
+	type Marker struct {
+		ID   int64
+		Tag  struct{}
+		Flag bool
+	}
+
+	type Mixed struct {
+		A bool
+		B string
+		C int16
+		D [3]byte
+		E *int
+		F struct{}
+	}
+
+	tests := []struct {
+		name      string
+		input     interface{}
+		padding   uintptr
+		suggested []string
+	}{
+		{"padded", Flags{}, 14, []string{"ID", "Enabled", "Visible"}},
+		{"packed", Packed{}, 3, []string{"ID", "N", "Flag"}},
+		{"zero size", Marker{}, 7, []string{"Tag", "ID", "Flag"}},
+		{"mixed", []Mixed{}, 18, []string{"F", "B", "E", "C", "A", "D"}},
+		{"empty", struct{}{}, 0, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := Of(tt.input)
+			l, ok := k.Layout()
+			if !ok {
+				t.Fatal("Expected layout")
+			}
+
+			st := k.structType()
+			if l.Size != st.Size() || l.Padding != tt.padding {
+				t.Errorf("Expected size %d and padding %d, but got %d and %d",
+					st.Size(), tt.padding, l.Size, l.Padding)
+			}
+
+			sum := uintptr(0)
+			for i, f := range l.Fields {
+				sf := st.Field(i)
+				if f.Name != sf.Name || f.Offset != sf.Offset ||
+					f.Size != sf.Type.Size() {
+					t.Errorf("Expected field %s at %d, but got %+v",
+						sf.Name, sf.Offset, f)
+				}
+
+				sum += f.Size + f.Padding
+			}
+
+			if sum != l.Size {
+				t.Errorf("Expected fields to cover %d bytes, but got %d",
+					l.Size, sum)
+			}
+
+			if !reflect.DeepEqual(l.Suggested, tt.suggested) {
+				t.Errorf("Expected order %v, but got %v",
+					tt.suggested, l.Suggested)
+			}
+
+			// The suggested size must match the compiler's layout.
+			fields := make([]reflect.StructField, len(l.Suggested))
+			for i, name := range l.Suggested {
+				fields[i], _ = st.FieldByName(name)
+				fields[i].Index, fields[i].Offset = nil, 0
+			}
+
+			if size := reflect.StructOf(fields).Size(); l.SuggestedSize != size {
+				t.Errorf("Expected suggested size %d, but got %d",
+					size, l.SuggestedSize)
+			}
+		})
+	}
+
+	if _, ok := Of(1).Layout(); ok {
+		t.Error("Expected no layout for int")
+	}
+}
+
+// TestOptimalFieldOrder tests the OptimalFieldOrder function.
+func TestOptimalFieldOrder(t *testing.T) {
+	type Record struct {
+		Valid   bool
+		Count   int32
+		Deleted bool
+		Total   int64
+		Code    int16
+	}
+
+	order := OptimalFieldOrder(Of(&Record{}))
+	expected := []string{"Total", "Count", "Code", "Valid", "Deleted"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("Expected %v, but got %v", expected, order)
+	}
+
+	if order := OptimalFieldOrder(Of("Record")); order != nil {
+		t.Errorf("Expected nil, but got %v", order)
+	}
+}
@@ -0,0 +1,255 @@
+package kind
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	// ErrKindMismatch is returned by Merge when the values to merge
+	// have different kinds, and by WithValue when the value does not
+	// match the Kind.
+	ErrKindMismatch = errors.New("kind: kind mismatch")
+
+	// ErrInvalidDestination is returned by Merge when the
+	// destination is not a non-nil pointer.
+	ErrInvalidDestination = errors.New("kind: destination must be a non-nil pointer")
+)
+
+// MergeStrategy defines how Merge combines the values.
+type MergeStrategy int
+
+const (
+	// MergeOverwrite merges structs and maps recursively, while any other
+	// non-zero source value, including a slice, replaces the destination.
+	MergeOverwrite MergeStrategy = iota
+
+	// MergeAppend works like MergeOverwrite, but appends
+	// the source slices to the destination slices.
+	MergeAppend
+
+	// MergeDeepUnion merges structs and maps recursively, appends the
+	// elements of the source slices that are not already in the
+	// destination slices, and sets the other destination values from
+	// the source only if they are zero.
+	MergeDeepUnion
+)
+
+// Merge merges the src value into the value pointed to by dst using the
+// strategy. The src value must be of the same type as the dst value or
+// a pointer to it. Values held by interfaces, such as the elements of
+// map[string]interface{}, are merged if their dynamic types match.
+//
+// Only the exported fields of structs are merged. The maps, slices and
+// pointers taken from src are deep copies, so src is never changed by
+// later merges into dst. It returns ErrKindMismatch, naming the path of
+// the node, if the kinds of the values do not match, and ErrCyclicValue
+// if src refers to itself through pointers; in these cases dst can be
+// partially merged.
+//
+// Example usage:
+//
+//	base := map[string]interface{}{
+//		"server": map[string]interface{}{"port": 80},
+//		"tags":   []interface{}{"a"},
+//	}
+//	override := map[string]interface{}{
+//		"server": map[string]interface{}{"host": "local"},
+//		"tags":   []interface{}{"b"},
+//	}
+//
+//	err := kind.Merge(&base, override, kind.MergeAppend)
+//	fmt.Println(base, err)
+//	// map[server:map[host:local port:80] tags:[a b]] <nil>
+func Merge(dst, src interface{}, strategy MergeStrategy) error {
+	d := reflect.ValueOf(dst)
+	if d.Kind() != reflect.Ptr || d.IsNil() {
+		return ErrInvalidDestination
+	}
+
+	s := reflect.ValueOf(src)
+	if s.IsValid() && s.Type() == d.Type() {
+		if s.IsNil() {
+			return nil
+		}
+
+		s = s.Elem()
+	}
+
+	return merge(d.Elem(), s, "", strategy, map[[2]uintptr]bool{})
+}
+
+// merge merges the src value into the settable dst value, the seen
+// map holds the pairs of dst and src pointers being merged.
+func merge(
+	dst, src reflect.Value,
+	path string,
+	strategy MergeStrategy,
+	seen map[[2]uintptr]bool,
+) error {
+	if !src.IsValid() {
+		return nil
+	}
+
+	if dst.Type() != src.Type() {
+		return mismatch(path, dst.Type(), src.Type())
+	}
+
+	switch dst.Kind() {
+	case reflect.Struct:
+		t := dst.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+
+			err := merge(dst.Field(i), src.Field(i),
+				fieldPath(path, t.Field(i).Name), strategy, seen)
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if src.IsNil() {
+			return nil
+		}
+
+		if dst.IsNil() {
+			dst.Set(reflect.MakeMapWithSize(dst.Type(), src.Len()))
+		}
+
+		for _, key := range sortedKeys(src) {
+			old := dst.MapIndex(key)
+			if !old.IsValid() {
+				v, err := copyValue(src.MapIndex(key), keyPath(path, key))
+				if err != nil {
+					return err
+				}
+
+				dst.SetMapIndex(key, v)
+				continue
+			}
+
+			v := reflect.New(old.Type()).Elem()
+			v.Set(old)
+			err := merge(v, src.MapIndex(key), keyPath(path, key),
+				strategy, seen)
+			if err != nil {
+				return err
+			}
+
+			dst.SetMapIndex(key, v)
+		}
+	case reflect.Interface:
+		if src.IsNil() {
+			return nil
+		}
+
+		if dst.IsNil() {
+			return set(dst, src, path)
+		}
+
+		if dst.Elem().Type() != src.Elem().Type() {
+			return mismatch(path, dst.Elem().Type(), src.Elem().Type())
+		}
+
+		v := reflect.New(dst.Elem().Type()).Elem()
+		v.Set(dst.Elem())
+		if err := merge(v, src.Elem(), path, strategy, seen); err != nil {
+			return err
+		}
+
+		dst.Set(v)
+	case reflect.Ptr:
+		if src.IsNil() {
+			return nil
+		}
+
+		if dst.IsNil() {
+			return set(dst, src, path)
+		}
+
+		// Both values can refer to themselves, e.g. the nodes of a ring.
+		pair := [2]uintptr{dst.Pointer(), src.Pointer()}
+		if seen[pair] {
+			return fmt.Errorf("%w: %s", ErrCyclicValue, path)
+		}
+
+		seen[pair] = true
+		defer delete(seen, pair)
+
+		return merge(dst.Elem(), src.Elem(), path, strategy, seen)
+	case reflect.Slice:
+		switch strategy {
+		case MergeAppend:
+			s, err := copyValue(src, path)
+			if err != nil {
+				return err
+			}
+
+			dst.Set(reflect.AppendSlice(dst, s))
+		case MergeDeepUnion:
+			for i := 0; i < src.Len(); i++ {
+				if containsValue(dst, src.Index(i)) {
+					continue
+				}
+
+				e, err := copyValue(src.Index(i), indexPath(path, i))
+				if err != nil {
+					return err
+				}
+
+				dst.Set(reflect.Append(dst, e))
+			}
+		default:
+			if !src.IsZero() {
+				return set(dst, src, path)
+			}
+		}
+	default:
+		if strategy == MergeDeepUnion && !dst.IsZero() {
+			return nil
+		}
+
+		if !src.IsZero() {
+			return set(dst, src, path)
+		}
+	}
+
+	return nil
+}
+
+// set sets dst to the deep copy of the src value.
+func set(dst, src reflect.Value, path string) error {
+	v, err := copyValue(src, path)
+	if err != nil {
+		return err
+	}
+
+	dst.Set(v)
+	return nil
+}
+
+// mismatch returns the ErrKindMismatch error for the path.
+func mismatch(path string, expected, actual reflect.Type) error {
+	if path == "" {
+		return fmt.Errorf("%w: expected %s, got %s",
+			ErrKindMismatch, expected, actual)
+	}
+
+	return fmt.Errorf("%w: %s: expected %s, got %s",
+		ErrKindMismatch, path, expected, actual)
+}
+
+// containsValue returns true if the slice contains an element
+// deeply equal to the value.
+func containsValue(s, v reflect.Value) bool {
+	for i := 0; i < s.Len(); i++ {
+		if reflect.DeepEqual(s.Index(i).Interface(), v.Interface()) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,215 @@
+package kind
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// TestMerge tests the Merge function.
+func TestMerge(t *testing.T) {
+	type Server struct {
+		Host string
+		Port int
+	}
+
+	type Config struct {
+		Name    string
+		Server  *Server
+		Tags    []string
+		Options map[string]interface{}
+	}
+
+	base := func() Config {
+		return Config{
+			Name:   "base",
+			Server: &Server{Host: "localhost"},
+			Tags:   []string{"a", "b"},
+			Options: map[string]interface{}{
+				"debug": false,
+				"log":   map[string]interface{}{"level": "info"},
+			},
+		}
+	}
+
+	override := Config{
+		Name:   "override",
+		Server: &Server{Port: 8080},
+		Tags:   []string{"b", "c", "c"},
+		Options: map[string]interface{}{
+			"debug": true,
+			"log":   map[string]interface{}{"file": "out.log"},
+		},
+	}
+
+	options := func() map[string]interface{} {
+		return map[string]interface{}{
+			"debug": true,
+			"log": map[string]interface{}{
+				"level": "info",
+				"file":  "out.log",
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		strategy MergeStrategy
+		expected Config
+	}{
+		{
+			name:     "overwrite",
+			strategy: MergeOverwrite,
+			expected: Config{
+				Name:    "override",
+				Server:  &Server{Host: "localhost", Port: 8080},
+				Tags:    []string{"b", "c", "c"},
+				Options: options(),
+			},
+		},
+		{
+			name:     "append",
+			strategy: MergeAppend,
+			expected: Config{
+				Name:    "override",
+				Server:  &Server{Host: "localhost", Port: 8080},
+				Tags:    []string{"a", "b", "b", "c", "c"},
+				Options: options(),
+			},
+		},
+		{
+			name:     "deep union",
+			strategy: MergeDeepUnion,
+			expected: Config{
+				Name:    "base",
+				Server:  &Server{Host: "localhost", Port: 8080},
+				Tags:    []string{"a", "b", "c"},
+				Options: options(), // false is zero, so it is set
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := base()
+			if err := Merge(&dst, &override, tt.strategy); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(dst, tt.expected) {
+				t.Errorf("Expected %+v, but got %+v", tt.expected, dst)
+			}
+		})
+	}
+}
+
+// TestMergeErrors tests the errors returned by the Merge function.
+func TestMergeErrors(t *testing.T) {
+	type Node struct {
+		Value int
+		Next  *Node
+	}
+
+	m := map[string]interface{}{"a": map[string]interface{}{"b": 1}}
+	cycle := &Node{Value: 1}
+	cycle.Next = cycle
+	ring := &Node{}
+	ring.Next = ring
+
+	tests := []struct {
+		name     string
+		dst      interface{}
+		src      interface{}
+		expected error
+		message  string
+	}{
+		{
+			name:     "not a pointer",
+			dst:      m,
+			src:      m,
+			expected: ErrInvalidDestination,
+		},
+		{
+			name:     "different types",
+			dst:      &m,
+			src:      1,
+			expected: ErrKindMismatch,
+			message:  "kind: kind mismatch: expected map[string]interface {}, got int",
+		},
+		{
+			name:     "nested mismatch",
+			dst:      &m,
+			src:      map[string]interface{}{"a": map[string]interface{}{"b": "x"}},
+			expected: ErrKindMismatch,
+			message:  "kind: kind mismatch: [a][b]: expected int, got string",
+		},
+		{
+			name:     "cyclic source",
+			dst:      &Node{},
+			src:      cycle,
+			expected: ErrCyclicValue,
+			message:  "kind: cyclic value: Next.Next",
+		},
+		{
+			name:     "cyclic source and destination",
+			dst:      ring,
+			src:      cycle,
+			expected: ErrCyclicValue,
+			message:  "kind: cyclic value: Next.Next",
+		},
+		{
+			name: "nil source",
+			dst:  &m,
+			src:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Merge(tt.dst, tt.src, MergeOverwrite)
+			if !errors.Is(err, tt.expected) || (err == nil) != (tt.expected == nil) {
+				t.Fatalf("Expected %v, but got %v", tt.expected, err)
+			}
+
+			if tt.message != "" && err.Error() != tt.message {
+				t.Errorf("Expected %q, but got %q", tt.message, err.Error())
+			}
+		})
+	}
+}
+
+// TestMergeCopiesSource tests that the Merge function
+// does not share the values of the sources with dst.
+func TestMergeCopiesSource(t *testing.T) {
+	base := map[string]interface{}{}
+	o1 := map[string]interface{}{
+		"server": map[string]interface{}{"port": 80},
+		"tags":   []interface{}{"a"},
+	}
+	o2 := map[string]interface{}{
+		"server": map[string]interface{}{"host": "local"},
+		"tags":   []interface{}{"b"},
+	}
+
+	for _, o := range []interface{}{o1, o2} {
+		if err := Merge(&base, o, MergeAppend); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	expected := map[string]interface{}{
+		"server": map[string]interface{}{"port": 80},
+		"tags":   []interface{}{"a"},
+	}
+	if !reflect.DeepEqual(o1, expected) {
+		t.Errorf("Expected the source to be unchanged, but got %v", o1)
+	}
+
+	expected = map[string]interface{}{
+		"server": map[string]interface{}{"host": "local", "port": 80},
+		"tags":   []interface{}{"a", "b"},
+	}
+	if !reflect.DeepEqual(base, expected) {
+		t.Errorf("Expected %v, but got %v", expected, base)
+	}
+}
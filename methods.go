@@ -0,0 +1,239 @@
+package kind
+
+import "reflect"
+
+// Method describes a method of a type.
+type Method struct {
+	Name            string // name of the method
+	Signature       string // signature without receiver, e.g. "func(int) error"
+	PointerReceiver bool   // method is only in the pointer method set
+}
+
+// InterfaceMethods returns the method set of the interface type
+// represented by the Kind instance, sorted by name. It returns nil
+// if the Kind instance does not represent an interface type.
+//
+// Example usage:
+//
+//	for _, m := range kind.For[fmt.Stringer]().InterfaceMethods() {
+//		fmt.Println(m.Name, m.Signature) // "String func() string"
+//	}
+func (k *Kind) InterfaceMethods() []Method {
+	if k.rtype == nil || k.rtype.Kind() != reflect.Interface {
+		return nil
+	}
+
+	methods := make([]Method, k.rtype.NumMethod())
+	for i := range methods {
+		m := k.rtype.Method(i)
+		methods[i] = Method{Name: m.Name, Signature: m.Type.String()}
+	}
+
+	return methods
+}
+
+// MethodSpec describes a method of an interface type.
+type MethodSpec struct {
+	Name string // name of the method
+	Kind *Kind  // kind of the signature without receiver, a function type
+}
+
+// MethodSpecs returns the method set of the interface type represented
+// by the Kind instance, sorted by name, with the signatures as Kind
+// instances. It returns nil if the Kind instance does not represent an
+// interface type.
+//
+// Example usage:
+//
+//	for _, m := range kind.For[io.Reader]().MethodSpecs() {
+//		fmt.Println(m.Name, m.Kind) // "Read func([]uint8) (int, error)"
+//	}
+func (k *Kind) MethodSpecs() []MethodSpec {
+	if k.rtype == nil || k.rtype.Kind() != reflect.Interface {
+		return nil
+	}
+
+	specs := make([]MethodSpec, k.rtype.NumMethod())
+	for i := range specs {
+		m := k.rtype.Method(i)
+		specs[i] = MethodSpec{Name: m.Name, Kind: ofType(m.Type)}
+	}
+
+	return specs
+}
+
+// SatisfiedBy returns true if the Kind instance represents an interface
+// type and the type represented by the other Kind instance implements
+// it. It is the reverse of Implements for interface descriptors.
+//
+// Example usage:
+//
+//	stringer := kind.For[fmt.Stringer]()
+//	fmt.Println(stringer.SatisfiedBy(kind.Of(time.Second))) // true
+func (k *Kind) SatisfiedBy(other *Kind) bool {
+	if k.rtype == nil || k.rtype.Kind() != reflect.Interface {
+		return false
+	}
+
+	return other != nil && other.rtype != nil &&
+		other.rtype.Implements(k.rtype)
+}
+
+// Methods returns the exported methods of the type represented by the
+// Kind instance, sorted by name. For a type T or *T, it returns the
+// method set of *T, which includes the methods with value receivers
+// and the methods with pointer receivers, the latter are marked by
+// the PointerReceiver field. For an interface type, it returns the
+// same as InterfaceMethods.
+//
+// Example usage:
+//
+//	type Counter struct{ n int }
+//	func (c Counter) Value() int { return c.n }
+//	func (c *Counter) Inc()      { c.n++ }
+//
+//	for _, m := range kind.Of(Counter{}).Methods() {
+//		fmt.Println(m.Name, m.PointerReceiver) // "Inc true", "Value false"
+//	}
+func (k *Kind) Methods() []Method {
+	if k.rtype == nil {
+		return nil
+	}
+
+	if k.rtype.Kind() == reflect.Interface {
+		return k.InterfaceMethods()
+	}
+
+	// The value type T is the element of *T, unless T
+	// is an unnamed pointer type itself.
+	t := k.rtype
+	if t.Kind() == reflect.Ptr && t.Name() == "" {
+		t = t.Elem()
+	}
+
+	pt := reflect.PtrTo(t)
+	methods := make([]Method, pt.NumMethod())
+	for i := range methods {
+		m := pt.Method(i)
+		_, inValueSet := t.MethodByName(m.Name)
+		methods[i] = Method{
+			Name:            m.Name,
+			Signature:       signature(m.Type, 1),
+			PointerReceiver: !inValueSet,
+		}
+	}
+
+	return methods
+}
+
+// AddressableMethods returns the methods that can be called on the value
+// stored in the Kind instance, sorted by name. The stored value is not
+// addressable, so for a non-pointer value only the methods with value
+// receivers can be called, while for a pointer value all methods can.
+// Calling a pointer receiver method on a non-addressable value through
+// reflect panics, so RPC registries should use this method set.
+func (k *Kind) AddressableMethods() []Method {
+	methods := k.Methods()
+	if k.rtype == nil || k.rtype.Kind() == reflect.Ptr {
+		return methods
+	}
+
+	result := make([]Method, 0, len(methods))
+	for _, m := range methods {
+		if !m.PointerReceiver {
+			result = append(result, m)
+		}
+	}
+
+	return result
+}
+
+// signature returns the signature of the function type without
+// the first skip parameters, e.g. without the receiver.
+func signature(t reflect.Type, skip int) string {
+	in := make([]reflect.Type, 0, t.NumIn()-skip)
+	for i := skip; i < t.NumIn(); i++ {
+		in = append(in, t.In(i))
+	}
+
+	out := make([]reflect.Type, t.NumOut())
+	for i := range out {
+		out[i] = t.Out(i)
+	}
+
+	return reflect.FuncOf(in, out, t.IsVariadic()).String()
+}
+
+// Implements returns true if the type represented by the Kind instance
+// implements the interface. See ImplementsAny for the accepted forms
+// of the interface argument.
+//
+// Example usage:
+//
+//	k := kind.Of(time.Now())
+//	fmt.Println(k.Implements((*fmt.Stringer)(nil))) // true
+func (k *Kind) Implements(iface interface{}) bool {
+	_, ok := k.ImplementsAny(iface)
+	return ok
+}
+
+// ImplementsAny returns the index of the first interface in the list
+// that the type represented by the Kind instance implements, and true.
+// If it implements none of them, it returns -1 and false.
+//
+// An interface can be given as a nil pointer to it, e.g.
+// (*json.Marshaler)(nil), as its reflect.Type or as a Kind instance
+// created by For or OfType. Arguments that are not interfaces are
+// ignored. The check uses the type of the stored value, so for a
+// non-pointer value the methods with pointer receivers do not count.
+//
+// Example usage:
+//
+//	i, ok := kind.Of(time.Now()).ImplementsAny(
+//		(*json.Marshaler)(nil),
+//		(*encoding.TextMarshaler)(nil),
+//	)
+//	fmt.Println(i, ok) // 0 true
+func (k *Kind) ImplementsAny(ifaces ...interface{}) (int, bool) {
+	if k.rtype == nil {
+		return -1, false
+	}
+
+	for i, iface := range ifaces {
+		if it := interfaceType(iface); it != nil && k.rtype.Implements(it) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// interfaceType returns the interface type given as a nil pointer to
+// the interface, a reflect.Type or a *Kind, or nil if the argument
+// does not denote an interface type. A nil *Kind is treated as nil.
+func interfaceType(iface interface{}) reflect.Type {
+	var t reflect.Type
+	switch v := iface.(type) {
+	case nil:
+		return nil
+	case reflect.Type:
+		t = v
+	case *Kind:
+		if v == nil || v.typeNode == nil {
+			return nil
+		}
+
+		t = v.rtype
+	default:
+		t = reflect.TypeOf(iface)
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+	}
+
+	if t == nil || t.Kind() != reflect.Interface {
+		return nil
+	}
+
+	return t
+}
@@ -0,0 +1,179 @@
+package kind
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// TestInterfaceMethods tests the Kind.InterfaceMethods method.
+func TestInterfaceMethods(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     *Kind
+		expected []Method
+	}{
+		{
+			"stringer",
+			For[fmt.Stringer](),
+			[]Method{{"String", "func() string", false}},
+		},
+		{
+			"read closer",
+			For[io.ReadCloser](),
+			[]Method{
+				{"Close", "func() error", false},
+				{"Read", "func([]uint8) (int, error)", false},
+			},
+		},
+		{"empty", For[interface{}](), []Method{}},
+		{"struct", Of(struct{}{}), nil},
+		{"nil", Of(nil), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			methods := tt.kind.InterfaceMethods()
+			if !reflect.DeepEqual(methods, tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, methods)
+			}
+		})
+	}
+}
+
+// TestMethodSpecs tests the Kind.MethodSpecs and Kind.SatisfiedBy methods.
+func TestMethodSpecs(t *testing.T) {
+	specs := For[io.ReadCloser]().MethodSpecs()
+	if len(specs) != 2 || specs[0].Name != "Close" || specs[1].Name != "Read" {
+		t.Fatalf("Unexpected method specs %v", specs)
+	}
+
+	read := specs[1].Kind
+	if !read.IsFunction() || read.Name() != "func([]uint8) (int, error)" {
+		t.Errorf("Expected the Read signature, but got %s", read)
+	}
+
+	if specs := Of(counter{}).MethodSpecs(); specs != nil {
+		t.Errorf("Expected nil for a struct, but got %v", specs)
+	}
+
+	tests := []struct {
+		name     string
+		iface    *Kind
+		other    *Kind
+		expected bool
+	}{
+		{"value receiver", For[interface{ Value() int }](), Of(counter{}), true},
+		{"pointer receiver", For[interface{ Reset() }](), Of(counter{}), false},
+		{"pointer", For[interface{ Reset() }](), Of(&counter{}), true},
+		{"interface", For[io.Reader](), For[io.ReadCloser](), true},
+		{"narrower", For[io.ReadCloser](), For[io.Reader](), false},
+		{"empty", For[interface{}](), Of(1), true},
+		{"not interface", Of(1), Of(1), false},
+		{"nil other", For[interface{}](), Of(nil), false},
+		{"nil kind", For[interface{}](), nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if r := tt.iface.SatisfiedBy(tt.other); r != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, r)
+			}
+		})
+	}
+}
+
+// counter is a type with value and pointer receiver methods.
+type counter struct{ n int }
+
+// Value returns the counter value.
+func (c counter) Value() int { return c.n }
+
+// Add adds the numbers to the counter.
+func (c *counter) Add(n ...int) (int, error) {
+	for _, v := range n {
+		c.n += v
+	}
+
+	return c.n, nil
+}
+
+// Reset resets the counter.
+func (c *counter) Reset() { c.n = 0 }
+
+// TestMethods tests the Kind.Methods and Kind.AddressableMethods methods.
+func TestMethods(t *testing.T) {
+	all := []Method{
+		{"Add", "func(...int) (int, error)", true},
+		{"Reset", "func()", true},
+		{"Value", "func() int", false},
+	}
+
+	tests := []struct {
+		name        string
+		kind        *Kind
+		methods     []Method
+		addressable []Method
+	}{
+		{"value", Of(counter{}), all, all[2:]},
+		{"pointer", Of(&counter{}), all, all},
+		{"interface", For[fmt.Stringer](), []Method{
+			{"String", "func() string", false},
+		}, []Method{{"String", "func() string", false}}},
+		{"no methods", Of(1), []Method{}, []Method{}},
+		{"nil", Of(nil), nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if m := tt.kind.Methods(); !reflect.DeepEqual(m, tt.methods) {
+				t.Errorf("Expected methods %v, but got %v", tt.methods, m)
+			}
+
+			m := tt.kind.AddressableMethods()
+			if !reflect.DeepEqual(m, tt.addressable) {
+				t.Errorf("Expected addressable methods %v, but got %v",
+					tt.addressable, m)
+			}
+		})
+	}
+}
+
+// TestImplementsAny tests the Kind.Implements and Kind.ImplementsAny methods.
+func TestImplementsAny(t *testing.T) {
+	stringer := (*fmt.Stringer)(nil)
+	valuer := reflect.TypeOf((*interface{ Value() int })(nil)).Elem()
+	resetter := For[interface{ Reset() }]()
+
+	tests := []struct {
+		name   string
+		kind   *Kind
+		ifaces []interface{}
+		index  int
+		ok     bool
+	}{
+		{"pointer form", Of(counter{}), []interface{}{stringer, valuer}, 1, true},
+		{"kind form", Of(&counter{}), []interface{}{resetter}, 0, true},
+		{"pointer receiver", Of(counter{}), []interface{}{resetter}, -1, false},
+		{"not interface", Of(counter{}), []interface{}{1, nil, (*int)(nil)}, -1, false},
+		{"none", Of(1), []interface{}{stringer}, -1, false},
+		{"empty", Of(1), nil, -1, false},
+		{"nil kind", Of(nil), []interface{}{stringer}, -1, false},
+		{"nil interface kind", Of(1), []interface{}{(*Kind)(nil), &Kind{}}, -1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, ok := tt.kind.ImplementsAny(tt.ifaces...)
+			if index != tt.index || ok != tt.ok {
+				t.Errorf("Expected %d %v, but got %d %v",
+					tt.index, tt.ok, index, ok)
+			}
+		})
+	}
+
+	if !Of(&counter{}).Implements(valuer) {
+		t.Error("Expected *counter to implement Value")
+	}
+}
@@ -0,0 +1,98 @@
+package kind
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+)
+
+// jsonNumberType is the type of numbers decoded by a json.Decoder
+// with UseNumber.
+var jsonNumberType = reflect.TypeOf(json.Number(""))
+
+// rawJSONType is the type of the raw encoded JSON values.
+var rawJSONType = reflect.TypeOf(json.RawMessage(nil))
+
+// IsJSONNumber returns true if the Kind instance represents json.Number,
+// the type of numbers decoded by a json.Decoder with UseNumber. Its flags
+// describe a string, but ToInt64 and ToFloat64 parse it as a number.
+func (k *Kind) IsJSONNumber() bool {
+	return k.rtype == jsonNumberType
+}
+
+// IsRawJSON returns true if the Kind instance represents json.RawMessage,
+// a raw encoded JSON value. Its flags describe a byte slice, but the
+// bytes hold a JSON document, whose own Kind can be inferred on demand
+// by kindjson.InferRaw.
+func (k *Kind) IsRawJSON() bool {
+	return k.rtype == rawJSONType
+}
+
+// ToInt64 returns the stored number converted to int64 if it can be
+// represented exactly: a signed or unsigned integer in range, a float
+// without fraction, or a json.Number holding such a number. Unlike
+// AsInt64, it accepts any numeric type, including named ones.
+//
+// Example usage:
+//
+//	n, ok := kind.Of(uint8(7)).ToInt64() // 7 true
+//	n, ok = kind.Of(json.Number("42")).ToInt64() // 42 true
+//	n, ok = kind.Of(1.5).ToInt64() // 0 false
+func (k *Kind) ToInt64() (int64, bool) {
+	if k.value == nil {
+		return 0, false
+	}
+
+	v := reflect.ValueOf(k.value)
+	switch {
+	case k.IsJSONNumber():
+		if n, err := k.value.(json.Number).Int64(); err == nil {
+			return n, true
+		}
+
+		f, err := k.value.(json.Number).Float64()
+		return floatToInt64(f, err == nil)
+	case v.CanInt():
+		return v.Int(), true
+	case v.CanUint():
+		if u := v.Uint(); u <= math.MaxInt64 {
+			return int64(u), true
+		}
+	case v.CanFloat():
+		return floatToInt64(v.Float(), true)
+	}
+
+	return 0, false
+}
+
+// ToFloat64 returns the stored number converted to float64: any signed
+// or unsigned integer or float, possibly losing precision for large
+// integers, or a json.Number. Unlike AsFloat64, it accepts any numeric
+// type, including named ones.
+//
+// Example usage:
+//
+//	f, ok := kind.Of(3).ToFloat64() // 3 true
+//	f, ok = kind.Of(json.Number("2.5")).ToFloat64() // 2.5 true
+func (k *Kind) ToFloat64() (float64, bool) {
+	if k.value == nil {
+		return 0, false
+	}
+
+	if k.IsJSONNumber() {
+		f, err := k.value.(json.Number).Float64()
+		return f, err == nil
+	}
+
+	return numberOf(reflect.ValueOf(k.value))
+}
+
+// floatToInt64 returns the float as int64 if it has no fraction
+// and is in range.
+func floatToInt64(f float64, ok bool) (int64, bool) {
+	if !ok || f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, false
+	}
+
+	return int64(f), true
+}
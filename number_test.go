@@ -0,0 +1,92 @@
+package kind
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+)
+
+// TestJSONNumber tests the IsJSONNumber, ToInt64 and ToFloat64 methods.
+func TestJSONNumber(t *testing.T) {
+	type Score float32
+
+	var decoded map[string]interface{}
+	d := json.NewDecoder(strings.NewReader(`{"n": 42, "f": 2.5}`))
+	d.UseNumber()
+	if err := d.Decode(&decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		input  interface{}
+		number bool
+		i      int64
+		iOK    bool
+		f      float64
+		fOK    bool
+	}{
+		{"decoded int", decoded["n"], true, 42, true, 42, true},
+		{"decoded float", decoded["f"], true, 0, false, 2.5, true},
+		{"integral float number", json.Number("1e3"), true, 1000, true, 1000, true},
+		{"invalid number", json.Number("x"), true, 0, false, 0, false},
+		{"int", -7, false, -7, true, -7, true},
+		{"uint8", uint8(7), false, 7, true, 7, true},
+		{"big uint", uint64(math.MaxUint64), false, 0, false, math.MaxUint64, true},
+		{"whole float", 3.0, false, 3, true, 3, true},
+		{"fraction", 1.5, false, 0, false, 1.5, true},
+		{"named float", Score(2), false, 2, true, 2, true},
+		{"string", "42", false, 0, false, 0, false},
+		{"nil", nil, false, 0, false, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := Of(tt.input)
+			if r := k.IsJSONNumber(); r != tt.number {
+				t.Errorf("Expected json.Number %v, but got %v", tt.number, r)
+			}
+
+			if i, ok := k.ToInt64(); i != tt.i || ok != tt.iOK {
+				t.Errorf("Expected int %d %v, but got %d %v", tt.i, tt.iOK, i, ok)
+			}
+
+			if f, ok := k.ToFloat64(); f != tt.f || ok != tt.fOK {
+				t.Errorf("Expected float %v %v, but got %v %v", tt.f, tt.fOK, f, ok)
+			}
+		})
+	}
+}
+
+// TestIsRawJSON tests the IsRawJSON method.
+func TestIsRawJSON(t *testing.T) {
+	var payload struct{ Data json.RawMessage }
+	if err := json.Unmarshal([]byte(`{"Data": {"a": 1}}`), &payload); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected bool
+	}{
+		{"decoded", payload.Data, true},
+		{"nil", json.RawMessage(nil), true},
+		{"bytes", []byte(`{}`), false},
+		{"string", `{}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := Of(tt.input)
+			if k.IsRawJSON() != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, k.IsRawJSON())
+			}
+
+			if tt.expected && (!k.IsSlice() || !k.IsUint8()) {
+				t.Errorf("Expected the flags of a byte slice, but got %+v", k)
+			}
+		})
+	}
+}
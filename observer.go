@@ -0,0 +1,89 @@
+package kind
+
+import (
+	"reflect"
+	"sync/atomic"
+)
+
+// EventType is the type of an Event.
+type EventType int
+
+const (
+	// EventCacheHit is sent when the type is found in the type cache.
+	EventCacheHit EventType = iota
+
+	// EventCacheMiss is sent when the type is not found in the type
+	// cache and is analyzed.
+	EventCacheMiss
+
+	// EventDeepRecursion is sent when the analysis of a type goes
+	// through DeepRecursionLevel nested pointers, slices or arrays.
+	EventDeepRecursion
+
+	// EventUnsupported is sent when the analyzed type cannot be fully
+	// represented by the Kind flags, see TryOf. The Err field of the
+	// event holds the reason.
+	EventUnsupported
+)
+
+// DeepRecursionLevel is the nesting level of pointers, slices and
+// arrays at which the EventDeepRecursion event is sent.
+const DeepRecursionLevel = 8
+
+// String returns the name of the event type.
+func (t EventType) String() string {
+	switch t {
+	case EventCacheHit:
+		return "cache hit"
+	case EventCacheMiss:
+		return "cache miss"
+	case EventDeepRecursion:
+		return "deep recursion"
+	case EventUnsupported:
+		return "unsupported type"
+	}
+
+	return "unknown"
+}
+
+// Event describes something that happened inside the package.
+type Event struct {
+	Type  EventType    // type of the event
+	RType reflect.Type // analyzed type
+	Depth int          // nesting level for EventDeepRecursion
+	Err   error        // reason for EventUnsupported
+}
+
+// The observer holds the function set by SetObserver.
+var observer atomic.Pointer[func(Event)]
+
+// SetObserver sets the function that receives the events of the package,
+// such as type cache hits and misses, or nil to stop sending events. The
+// function is called synchronously by the goroutine that caused the event,
+// so it must be fast and safe for concurrent use.
+//
+// Example usage:
+//
+//	var misses atomic.Int64
+//	kind.SetObserver(func(e kind.Event) {
+//		if e.Type == kind.EventCacheMiss {
+//			misses.Add(1)
+//		}
+//	})
+//	defer kind.SetObserver(nil)
+func SetObserver(fn func(Event)) {
+	if fn == nil {
+		observer.Store(nil)
+		return
+	}
+
+	observer.Store(&fn)
+}
+
+// notify sends the event to the observer, if any.
+// The event is built only if there is an observer.
+func notify(event func() Event) {
+	if fn := observer.Load(); fn != nil {
+		(*fn)(event())
+	}
+}
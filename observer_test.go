@@ -0,0 +1,89 @@
+package kind
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// TestSetObserver tests the events sent to the observer.
+func TestSetObserver(t *testing.T) {
+	type fresh struct{ A int }
+	type deep ********[]int
+	type loop []loop
+
+	var mu sync.Mutex
+	var events []Event
+	SetObserver(func(e Event) {
+		mu.Lock()
+		events = append(events, e)
+		mu.Unlock()
+	})
+	defer SetObserver(nil)
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected []EventType
+	}{
+		{"miss", fresh{}, []EventType{EventCacheMiss}},
+		{"hit", fresh{}, []EventType{EventCacheHit}},
+		{"deep", deep(nil), []EventType{EventCacheMiss, EventDeepRecursion}},
+		{"unsupported", [][][]fresh{}, []EventType{EventCacheMiss, EventUnsupported}},
+		{"self-referential", loop{}, []EventType{
+			EventCacheMiss, EventDeepRecursion, EventUnsupported}},
+		{"nil", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events = nil
+			Of(tt.input)
+
+			var got []EventType
+			for _, e := range events {
+				got = append(got, e.Type)
+				if e.RType != reflect.TypeOf(tt.input) {
+					t.Errorf("Expected type %v, but got %v",
+						reflect.TypeOf(tt.input), e.RType)
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+
+	events = nil
+	Of([][][]string{})
+	last := events[len(events)-1]
+	if !errors.Is(last.Err, ErrNestedSequence) {
+		t.Errorf("Expected ErrNestedSequence, but got %v", last.Err)
+	}
+
+	SetObserver(nil)
+	events = nil
+	Of(struct{ B int }{})
+	if len(events) != 0 {
+		t.Errorf("Expected no events, but got %v", events)
+	}
+}
+
+// TestEventTypeString tests the EventType.String method.
+func TestEventTypeString(t *testing.T) {
+	tests := map[EventType]string{
+		EventCacheHit:      "cache hit",
+		EventCacheMiss:     "cache miss",
+		EventDeepRecursion: "deep recursion",
+		EventUnsupported:   "unsupported type",
+		EventType(-1):      "unknown",
+	}
+
+	for et, expected := range tests {
+		if et.String() != expected {
+			t.Errorf("Expected %q, but got %q", expected, et.String())
+		}
+	}
+}
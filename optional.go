@@ -0,0 +1,182 @@
+package kind
+
+import (
+	"reflect"
+	"strings"
+)
+
+// IsOptional returns true if the Kind instance represents a pointer to
+// a scalar type, such as *int or *string, which is the common way to mark
+// a value that can be absent.
+//
+// Example usage:
+//
+//	fmt.Println(kind.For[*int]().IsOptional()) // true
+//	fmt.Println(kind.For[int]().IsOptional())  // false
+func (k *Kind) IsOptional() bool {
+	if k.rtype == nil || k.rtype.Kind() != reflect.Ptr {
+		return false
+	}
+
+	switch k.rtype.Elem().Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16,
+		reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Complex64, reflect.Complex128:
+		return true
+	}
+
+	return false
+}
+
+// IsOptional returns true if the field can legally be absent: its type
+// accepts nil, i.e. it is a pointer, slice, map, interface, function or
+// channel, or its json tag has the omitempty option.
+func (f Field) IsOptional() bool {
+	_, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+	for opts != "" {
+		var opt string
+		opt, opts, _ = strings.Cut(opts, ",")
+		if opt == "omitempty" {
+			return true
+		}
+	}
+
+	return f.Kind.rtype != nil && nullable(f.Kind.rtype)
+}
+
+// NullableFields returns the dot-separated paths of the exported fields
+// of the struct represented by the Kind instance that can legally be
+// absent, see Field.IsOptional. Nested structs, directly or through
+// a pointer, are included; the fields of embedded structs are promoted
+// and listed without the embedded type name, while other embedded
+// fields, such as *Nickname for type Nickname string, are listed under
+// their type name, as in encoding/json. The paths are listed in
+// declaration order. It returns nil if the Kind instance does not
+// represent a struct.
+//
+// Example usage:
+//
+//	type Address struct {
+//		City string
+//		Zip  *string
+//	}
+//
+//	type User struct {
+//		Name    string
+//		Email   string `json:"email,omitempty"`
+//		Address *Address
+//	}
+//
+//	fmt.Println(kind.Of(User{}).NullableFields())
+//	// [Email Address Address.Zip]
+func (k *Kind) NullableFields() []string {
+	t := k.structType()
+	if t == nil {
+		return nil
+	}
+
+	var paths []string
+	visitFields(t, "", map[reflect.Type]bool{}, func(path string, f Field) {
+		if f.IsOptional() {
+			paths = append(paths, path)
+		}
+	})
+
+	return paths
+}
+
+// FieldNullability describes whether a field of a struct can be absent.
+type FieldNullability struct {
+	Path     string // dot-separated path of the field
+	Kind     *Kind  // kind of the field type
+	Nilable  bool   // type of the field accepts nil
+	Optional bool   // field can legally be absent, see Field.IsOptional
+	Required bool   // field must be present, the opposite of Optional
+}
+
+// NullabilityReport returns the nullability of every exported field of
+// the struct represented by the Kind instance, including the fields of
+// nested structs and the embedded fields of other types than structs,
+// in the order and with the paths of NullableFields.
+// A nested field is reported relative to its parent, so a required
+// field of an optional struct is required when the struct is present.
+// It returns nil if the Kind instance does not represent a struct.
+//
+// Example usage:
+//
+//	for _, f := range kind.Of(User{}).NullabilityReport() {
+//		fmt.Println(f.Path, f.Nilable, f.Required)
+//	}
+//	// Name false true
+//	// Email false false
+//	// Address true false
+//	// Address.City false true
+//	// Address.Zip true false
+func (k *Kind) NullabilityReport() []FieldNullability {
+	t := k.structType()
+	if t == nil {
+		return nil
+	}
+
+	var report []FieldNullability
+	visitFields(t, "", map[reflect.Type]bool{}, func(path string, f Field) {
+		optional := f.IsOptional()
+		report = append(report, FieldNullability{
+			Path:     path,
+			Kind:     f.Kind,
+			Nilable:  nullable(f.Kind.rtype),
+			Optional: optional,
+			Required: !optional,
+		})
+	})
+
+	return report
+}
+
+// visitFields calls fn for the exported fields of the struct type and
+// of the structs nested in it directly or through a pointer, promoting
+// the fields of embedded structs. The other embedded fields are visited
+// as fields named after their type. The seen set holds the structs on the
+// current path to stop recursion on self-referencing types.
+func visitFields(
+	t reflect.Type,
+	prefix string,
+	seen map[reflect.Type]bool,
+	fn func(path string, f Field),
+) {
+	seen[t] = true
+	defer delete(seen, t)
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		ft := sf.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		// As in encoding/json, only embedded structs are promoted,
+		// other embedded fields are named after their type.
+		promoted := sf.Anonymous && ft.Kind() == reflect.Struct
+		if !sf.IsExported() && !promoted {
+			continue
+		}
+
+		path := prefix + sf.Name
+		if !promoted {
+			fn(path, newField(sf))
+		}
+
+		if ft.Kind() != reflect.Struct || seen[ft] {
+			continue
+		}
+
+		if promoted {
+			visitFields(ft, prefix, seen, fn)
+		} else {
+			visitFields(ft, path+".", seen, fn)
+		}
+	}
+}
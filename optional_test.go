@@ -0,0 +1,151 @@
+package kind
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestIsOptional tests the Kind.IsOptional method.
+func TestIsOptional(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     *Kind
+		expected bool
+	}{
+		{"pointer to int", For[*int](), true},
+		{"pointer to string", For[*string](), true},
+		{"int", For[int](), false},
+		{"pointer to struct", For[*struct{}](), false},
+		{"slice", For[[]int](), false},
+		{"nil", Of(nil), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.kind.IsOptional(); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestNullableFields tests the Kind.NullableFields method.
+func TestNullableFields(t *testing.T) {
+	type Base struct {
+		ID   int
+		Note *string
+	}
+
+	type Address struct {
+		City string
+		Zip  *string
+	}
+
+	type Node struct {
+		Value int
+		Next  *Node
+	}
+
+	type Nickname string
+	type Level int
+	type Labels []string
+
+	type Profile struct {
+		*Nickname
+		Level
+		Labels
+	}
+
+	type User struct {
+		Base
+		Name    string
+		Email   string `json:"email,omitempty"`
+		Age     int    `json:"age,string"`
+		Tags    []string
+		Address *Address
+		Home    Address
+		List    Node
+		secret  *int
+	}
+
+	tests := []struct {
+		name     string
+		kind     *Kind
+		expected []string
+	}{
+		{
+			name: "user",
+			kind: Of(User{}),
+			expected: []string{
+				"Note", "Email", "Tags", "Address", "Address.Zip",
+				"Home.Zip", "List.Next",
+			},
+		},
+		{"self-referencing", Of(&Node{}), []string{"Next"}},
+		{"embedded non-structs", Of(Profile{}), []string{"Nickname", "Labels"}},
+		{"not a struct", Of(1), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.kind.NullableFields()
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestNullabilityReport tests the Kind.NullabilityReport method.
+func TestNullabilityReport(t *testing.T) {
+	type Address struct {
+		City string
+		Zip  *string
+	}
+
+	type Nickname string
+	type Level int
+
+	type User struct {
+		*Nickname
+		Level
+		Name    string
+		Email   string `json:"email,omitempty"`
+		Tags    []string
+		Address *Address
+	}
+
+	expected := []struct {
+		path     string
+		kind     string
+		nilable  bool
+		optional bool
+	}{
+		{"Nickname", "*kind.Nickname", true, true},
+		{"Level", "kind.Level", false, false},
+		{"Name", "string", false, false},
+		{"Email", "string", false, true},
+		{"Tags", "[]string", true, true},
+		{"Address", "*kind.Address", true, true},
+		{"Address.City", "string", false, false},
+		{"Address.Zip", "*string", true, true},
+	}
+
+	report := Of(User{}).NullabilityReport()
+	if len(report) != len(expected) {
+		t.Fatalf("Expected %d fields, but got %+v", len(expected), report)
+	}
+
+	for i, e := range expected {
+		f := report[i]
+		if f.Path != e.path || f.Kind.Name() != e.kind ||
+			f.Nilable != e.nilable || f.Optional != e.optional ||
+			f.Required == e.optional {
+			t.Errorf("Expected %+v, but got %+v", e, f)
+		}
+	}
+
+	if report := Of("User").NullabilityReport(); report != nil {
+		t.Errorf("Expected nil, but got %+v", report)
+	}
+}
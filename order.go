@@ -0,0 +1,68 @@
+package kind
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// ErrNotSortable is returned by SortSlice when the value is not a slice
+// of an ordered element type.
+var ErrNotSortable = errors.New("kind: not a sortable slice")
+
+// IsOrdered returns true if the values of the type represented by
+// the Kind instance support the < operator: the integer, floating-point
+// and string types, including named ones. It is the same as
+// Satisfies(Ordered).
+func (k *Kind) IsOrdered() bool {
+	return k.Satisfies(Ordered)
+}
+
+// SortSlice sorts the slice v of an ordered element type, see IsOrdered,
+// in place in ascending order, with NaNs before the other floats, like
+// sort.Float64s. If v is nil, the slice held by the Kind instance is
+// sorted. The slice must have the type represented by the Kind instance,
+// otherwise SortSlice returns ErrKindMismatch; if it is not a slice or
+// its elements are not ordered, SortSlice returns ErrNotSortable.
+//
+// Example usage:
+//
+//	names := []string{"bob", "alice"}
+//	err := kind.Of(names).SortSlice(nil)
+//	fmt.Println(names, err) // [alice bob] <nil>
+func (k *Kind) SortSlice(v interface{}) error {
+	if v == nil {
+		v = k.value
+	}
+
+	s := reflect.ValueOf(v)
+	if s.Kind() != reflect.Slice || !isOrderedKind(s.Type().Elem().Kind()) {
+		return fmt.Errorf("%w: %T", ErrNotSortable, v)
+	}
+
+	if k.rtype != nil && s.Type() != k.rtype {
+		return mismatch("", k.rtype, s.Type())
+	}
+
+	var less func(i, j int) bool
+	switch rk := s.Type().Elem().Kind(); {
+	case rk >= reflect.Int && rk <= reflect.Int64:
+		less = func(i, j int) bool { return s.Index(i).Int() < s.Index(j).Int() }
+	case rk >= reflect.Uint && rk <= reflect.Uintptr:
+		less = func(i, j int) bool { return s.Index(i).Uint() < s.Index(j).Uint() }
+	case rk == reflect.String:
+		less = func(i, j int) bool {
+			return s.Index(i).String() < s.Index(j).String()
+		}
+	default:
+		less = func(i, j int) bool {
+			a, b := s.Index(i).Float(), s.Index(j).Float()
+			return a < b || math.IsNaN(a) && !math.IsNaN(b)
+		}
+	}
+
+	sort.Slice(v, less)
+	return nil
+}
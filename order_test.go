@@ -0,0 +1,97 @@
+package kind
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// TestIsOrdered tests the IsOrdered method.
+func TestIsOrdered(t *testing.T) {
+	type Name string
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected bool
+	}{
+		{"int", 1, true},
+		{"uint64", uint64(1), true},
+		{"float", 1.5, true},
+		{"string", "a", true},
+		{"named", Name("a"), true},
+		{"bool", true, false},
+		{"complex", 1i, false},
+		{"slice", []int{}, false},
+		{"nil", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Of(tt.input).IsOrdered(); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestSortSlice tests the SortSlice method.
+func TestSortSlice(t *testing.T) {
+	type Name string
+
+	nan := math.NaN()
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected interface{}
+	}{
+		{"ints", []int{3, -1, 2}, []int{-1, 2, 3}},
+		{"uints", []uint8{9, 0, 4}, []uint8{0, 4, 9}},
+		{"strings", []string{"b", "c", "a"}, []string{"a", "b", "c"}},
+		{"named", []Name{"y", "x"}, []Name{"x", "y"}},
+		{"empty", []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Of(tt.input).SortSlice(nil); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.input, tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, tt.input)
+			}
+		})
+	}
+
+	floats := []float64{2, nan, -1}
+	if err := For[[]float64]().SortSlice(floats); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !math.IsNaN(floats[0]) || floats[1] != -1 || floats[2] != 2 {
+		t.Errorf("Expected [NaN -1 2], but got %v", floats)
+	}
+
+	errs := []struct {
+		name  string
+		kind  *Kind
+		input interface{}
+		err   error
+	}{
+		{"not a slice", Of(1), nil, ErrNotSortable},
+		{"not ordered", Of([]bool{}), nil, ErrNotSortable},
+		{"array", Of([2]int{}), nil, ErrNotSortable},
+		{"nil", Of(nil), nil, ErrNotSortable},
+		{"other type", For[[]int](), []int64{1}, ErrKindMismatch},
+	}
+
+	for _, tt := range errs {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.kind.SortSlice(tt.input); !errors.Is(err, tt.err) {
+				t.Errorf("Expected %v, but got %v", tt.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package kind
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// ErrParseValue is returned by ParseAs when the string
+// cannot be parsed as a value of the target kind.
+var ErrParseValue = errors.New("kind: cannot parse value")
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	timeType            = reflect.TypeOf(time.Time{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// timeLayouts are the layouts tried by ParseAs for time.Time, in order.
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"}
+
+// ParseAs parses the string as a value of the type represented by the
+// target Kind, which is how environment variables, flags and config
+// files are bound to typed values. Numbers and bools are parsed by
+// strconv, time.Duration by time.ParseDuration, time.Time as RFC 3339
+// or a date, and the types implementing encoding.TextUnmarshaler by
+// their UnmarshalText. Strings, byte and rune slices take the string as
+// is, pointers get a pointer to the parsed element, and other slices,
+// arrays, maps and structs are decoded from JSON. An empty interface
+// gets the string itself. Otherwise, or if the string is malformed,
+// ParseAs returns the ErrParseValue error.
+//
+// Example usage:
+//
+//	v, err := kind.ParseAs(kind.For[time.Duration](), "1m30s")
+//	fmt.Println(v, err) // 1m30s <nil>
+//
+//	v, err = kind.ParseAs(kind.For[map[string]int](), `{"a":1}`)
+//	fmt.Println(v, err) // map[a:1] <nil>
+func ParseAs(target *Kind, s string) (interface{}, error) {
+	if target == nil || target.rtype == nil {
+		return nil, fmt.Errorf("%w: %q: no target type", ErrParseValue, s)
+	}
+
+	v, err := parseValue(target.rtype, s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q as %s: %v",
+			ErrParseValue, s, target.rtype, err)
+	}
+
+	return v.Interface(), nil
+}
+
+// parseValue parses the string as a value of the type.
+func parseValue(t reflect.Type, s string) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+	switch {
+	case t == durationType:
+		d, err := time.ParseDuration(s)
+		v.SetInt(int64(d))
+		return v, err
+	case t == timeType:
+		var err error
+		for _, layout := range timeLayouts {
+			var tm time.Time
+			if tm, err = time.Parse(layout, s); err == nil {
+				v.Set(reflect.ValueOf(tm))
+				break
+			}
+		}
+
+		return v, err
+	case reflect.PtrTo(t).Implements(textUnmarshalerType):
+		err := v.Addr().Interface().(encoding.TextUnmarshaler).
+			UnmarshalText([]byte(s))
+		return v, err
+	case textType(t):
+		return reflect.ValueOf(s).Convert(t), nil
+	}
+
+	var err error
+	switch t.Kind() {
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(s)
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		var n int64
+		n, err = strconv.ParseInt(s, 0, t.Bits())
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		var n uint64
+		n, err = strconv.ParseUint(s, 0, t.Bits())
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		f, err = strconv.ParseFloat(s, t.Bits())
+		v.SetFloat(f)
+	case reflect.Complex64, reflect.Complex128:
+		var c complex128
+		c, err = strconv.ParseComplex(s, t.Bits())
+		v.SetComplex(c)
+	case reflect.Ptr:
+		var elem reflect.Value
+		if elem, err = parseValue(t.Elem(), s); err == nil {
+			v = reflect.New(t.Elem())
+			v.Elem().Set(elem)
+		}
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Struct:
+		err = json.Unmarshal([]byte(s), v.Addr().Interface())
+	case reflect.Interface:
+		if t.NumMethod() != 0 {
+			return v, errors.New("unsupported interface type")
+		}
+
+		v.Set(reflect.ValueOf(s))
+	default:
+		return v, errors.New("unsupported type")
+	}
+
+	return v, err
+}
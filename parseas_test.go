@@ -0,0 +1,86 @@
+package kind
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestParseAs tests the ParseAs function.
+func TestParseAs(t *testing.T) {
+	type Level int
+	type Config struct {
+		Name  string `json:"name"`
+		Ports []int  `json:"ports"`
+	}
+
+	n := 8080
+	tests := []struct {
+		name     string
+		target   *Kind
+		input    string
+		expected interface{}
+	}{
+		{"bool", For[bool](), "true", true},
+		{"int", For[int](), "-42", -42},
+		{"hex", For[uint16](), "0xff", uint16(255)},
+		{"named int", For[Level](), "3", Level(3)},
+		{"float", For[float32](), "1.5", float32(1.5)},
+		{"complex", For[complex128](), "1+2i", 1 + 2i},
+		{"string", For[string](), "text", "text"},
+		{"bytes", For[[]byte](), "raw", []byte("raw")},
+		{"runes", For[[]rune](), "gö", []rune("gö")},
+		{"duration", For[time.Duration](), "1m30s", 90 * time.Second},
+		{"time", For[time.Time](), "2024-05-01",
+			time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"text unmarshaler", For[net.IP](), "10.0.0.1", net.ParseIP("10.0.0.1")},
+		{"pointer", For[*int](), "8080", &n},
+		{"slice", For[[]int](), "[1,2]", []int{1, 2}},
+		{"map", For[map[string]int](), `{"a":1}`, map[string]int{"a": 1}},
+		{"struct", For[Config](), `{"name":"api","ports":[80]}`,
+			Config{Name: "api", Ports: []int{80}}},
+		{"interface", For[interface{}](), "any", "any"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := ParseAs(tt.target, tt.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(v, tt.expected) {
+				t.Errorf("Expected %#v, but got %#v", tt.expected, v)
+			}
+		})
+	}
+}
+
+// TestParseAsErrors tests the ParseAs function for invalid input.
+func TestParseAsErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		target *Kind
+		input  string
+	}{
+		{"overflow", For[int8](), "300"},
+		{"bool", For[bool](), "yes please"},
+		{"duration", For[time.Duration](), "soon"},
+		{"time", For[time.Time](), "yesterday"},
+		{"json", For[[]int](), "1,2"},
+		{"channel", For[chan int](), "1"},
+		{"interface", For[error](), "failed"},
+		{"no type", Of(nil), "1"},
+		{"nil", nil, "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseAs(tt.target, tt.input); !errors.Is(err, ErrParseValue) {
+				t.Errorf("Expected ErrParseValue, but got %v", err)
+			}
+		})
+	}
+}
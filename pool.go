@@ -0,0 +1,61 @@
+package kind
+
+import "sync"
+
+// The kindPool holds released Kind instances for reuse by AcquireOf.
+var kindPool = sync.Pool{
+	New: func() interface{} {
+		return new(Kind)
+	},
+}
+
+// AcquireOf works like Of, but takes the Kind instance from a pool of
+// released instances. It is intended for short-lived inspections in hot
+// paths, where the instance is returned to the pool by Release as soon
+// as it is no longer needed.
+//
+// Example usage:
+//
+//	k := kind.AcquireOf(v)
+//	isInt := k.IsInt()
+//	k.Release()
+func AcquireOf(v interface{}) *Kind {
+	k := kindPool.Get().(*Kind)
+	k.init(v)
+	k.pooled = true
+
+	return k
+}
+
+// AcquireWith works like AcquireOf, but reuses the type details of the
+// Kind instance instead of looking them up in the type cache, like
+// WithValue. The value is not checked, so it must be of the represented
+// type; the method is intended for generated code, such as the helpers
+// written by kindgen, where the type of the value is known statically.
+//
+// Example usage:
+//
+//	userKind := kind.For[*User]()
+//
+//	k := userKind.AcquireWith(u)
+//	name := k.Name()
+//	k.Release()
+func (k *Kind) AcquireWith(v interface{}) *Kind {
+	c := kindPool.Get().(*Kind)
+	*c = Kind{typeNode: k.typeNode, value: v, pooled: true}
+
+	return c
+}
+
+// Release returns the Kind instance acquired by AcquireOf to the pool.
+// The instance must not be used after the call. Release does nothing
+// for instances that were not acquired by AcquireOf, such as the ones
+// created by Of or child Kinds, so they are never reset by mistake.
+func (k *Kind) Release() {
+	if k == nil || !k.pooled {
+		return
+	}
+
+	*k = Kind{}
+	kindPool.Put(k)
+}
@@ -0,0 +1,72 @@
+package kind
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestAcquireOf tests the kind.AcquireOf function and Kind.Release method.
+func TestAcquireOf(t *testing.T) {
+	values := []interface{}{42, "test", map[string][]int{}, nil, 42}
+	for _, v := range values {
+		k := AcquireOf(v)
+		if ok, result := deepEqualKind(Of(v), k); !ok {
+			t.Errorf("DeepEqual expected kind %+v, but got %+v:\n%s",
+				Of(v), k, result)
+		}
+
+		if !reflect.DeepEqual(k.value, v) {
+			t.Errorf("Expected value %v, but got %v", v, k.value)
+		}
+
+		k.Release()
+		if k.typeNode != nil || k.value != nil || k.pooled {
+			t.Errorf("Expected released kind to be reset, but got %+v", k)
+		}
+	}
+}
+
+// TestAcquireWith tests the Kind.AcquireWith method.
+func TestAcquireWith(t *testing.T) {
+	type User struct{ Name string }
+
+	base := For[*User]()
+	u := &User{Name: "Bob"}
+	k := base.AcquireWith(u)
+	if k.typeNode != base.typeNode || k.value != u || !k.pooled {
+		t.Errorf("Expected pooled kind of %T with the value, but got %+v", u, k)
+	}
+
+	k.Release()
+	if base.value != nil || base.pooled {
+		t.Errorf("Expected the base kind to be unchanged, but got %+v", base)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		base.AcquireWith(u).Release()
+	})
+	if allocs != 0 {
+		t.Errorf("Expected no allocations, but got %v", allocs)
+	}
+}
+
+// TestReleaseNotPooled tests that Kind.Release does not reset
+// instances that were not acquired from the pool.
+func TestReleaseNotPooled(t *testing.T) {
+	k := Of(map[string]int{})
+	k.Release()
+	if !k.IsMap() {
+		t.Error("Expected kind created by Of to be unchanged")
+	}
+
+	p := AcquireOf(map[string]int{})
+	key := p.MapKeyKind()
+	key.Release()
+	if !key.IsString() {
+		t.Error("Expected child kind to be unchanged")
+	}
+
+	p.Release()
+	var n *Kind
+	n.Release() // must not panic
+}
@@ -0,0 +1,82 @@
+package kind
+
+import (
+	"reflect"
+	"sync/atomic"
+)
+
+// The protoCheck holds the function set by SetProtoMessageCheck.
+var protoCheck atomic.Pointer[func(reflect.Type) bool]
+
+// SetProtoMessageCheck sets the function used by IsProtoMessage to check
+// whether a type implements the protobuf message interface, or nil to
+// restore the default check. The package does not depend on protobuf,
+// so the default check only looks at the method names and signatures;
+// the programs that use protobuf can inject the exact check.
+//
+// Example usage:
+//
+//	message := reflect.TypeOf((*proto.Message)(nil)).Elem()
+//	kind.SetProtoMessageCheck(func(t reflect.Type) bool {
+//		return t.Implements(message)
+//	})
+func SetProtoMessageCheck(fn func(reflect.Type) bool) {
+	if fn == nil {
+		protoCheck.Store(nil)
+		return
+	}
+
+	protoCheck.Store(&fn)
+}
+
+// IsProtoMessage returns true if the type represented by the Kind
+// instance, or a pointer to it, implements the protobuf message
+// interface, so transcoding layers can pick a protobuf serializer for
+// it. By default, it recognizes the messages generated by the current
+// API, which have the ProtoReflect method, and by the legacy API, which
+// have the ProtoMessage, Reset and String methods; see
+// SetProtoMessageCheck for the exact check.
+//
+// Example usage:
+//
+//	k := kind.Of(&pb.User{})
+//	fmt.Println(k.IsProtoMessage()) // true
+func (k *Kind) IsProtoMessage() bool {
+	if k.rtype == nil {
+		return false
+	}
+
+	check := isProtoMessage
+	if fn := protoCheck.Load(); fn != nil {
+		check = *fn
+	}
+
+	if check(k.rtype) {
+		return true
+	}
+
+	return k.rtype.Kind() != reflect.Ptr && k.rtype.Kind() != reflect.Interface &&
+		check(reflect.PtrTo(k.rtype))
+}
+
+// isProtoMessage returns true if the type has the methods of
+// a protobuf message.
+func isProtoMessage(t reflect.Type) bool {
+	if m, ok := t.MethodByName("ProtoReflect"); ok {
+		// An interface method has no receiver in its type.
+		in := m.Type.NumIn()
+		if t.Kind() != reflect.Interface {
+			in--
+		}
+
+		return in == 0 && m.Type.NumOut() == 1
+	}
+
+	for _, name := range []string{"ProtoMessage", "Reset", "String"} {
+		if _, ok := t.MethodByName(name); !ok {
+			return false
+		}
+	}
+
+	return true
+}
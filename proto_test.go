@@ -0,0 +1,61 @@
+package kind
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// The protoV2 is a message of the current protobuf API.
+type protoV2 struct{ Name string }
+
+// ProtoReflect returns the message itself, instead of protoreflect.Message.
+func (m *protoV2) ProtoReflect() interface{} { return m }
+
+// The protoV1 is a message of the legacy protobuf API.
+type protoV1 struct{ Name string }
+
+func (m *protoV1) ProtoMessage()  {}
+func (m *protoV1) Reset()         { *m = protoV1{} }
+func (m *protoV1) String() string { return m.Name }
+
+// The notProto has only some of the methods of a legacy message.
+type notProto struct{}
+
+func (notProto) Reset()         {}
+func (notProto) String() string { return "" }
+
+// TestIsProtoMessage tests the IsProtoMessage method.
+func TestIsProtoMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     *Kind
+		expected bool
+	}{
+		{"current", Of(&protoV2{}), true},
+		{"legacy", Of(&protoV1{}), true},
+		{"struct", Of(protoV2{}), true},
+		{"interface", For[interface{ ProtoReflect() interface{} }](), true},
+		{"partial", Of(notProto{}), false},
+		{"stringer", For[fmt.Stringer](), false},
+		{"slice", Of([]*protoV2{}), false},
+		{"scalar", Of(1), false},
+		{"nil", Of(nil), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.kind.IsProtoMessage(); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+
+	v1 := reflect.TypeOf(&protoV1{})
+	SetProtoMessageCheck(func(t reflect.Type) bool { return t == v1 })
+	defer SetProtoMessageCheck(nil)
+
+	if !Of(&protoV1{}).IsProtoMessage() || Of(&protoV2{}).IsProtoMessage() {
+		t.Error("Expected the injected check to be used")
+	}
+}
@@ -0,0 +1,169 @@
+package kind
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrCyclicValue is returned when a value refers to itself through
+// pointers and cannot be copied, and by Walk in the CycleError mode.
+var ErrCyclicValue = errors.New("kind: cyclic value")
+
+// Redact returns a deep copy of the value in which the nodes selected by
+// the rule are replaced with zero values. The rule is called for the same
+// nodes and paths as the function given to Walk; the children of
+// a selected node are not visited.
+//
+// Pointers, slices, arrays, maps and the exported fields of structs are
+// copied, while unexported fields, functions and channels are shared
+// with the original value. It returns ErrCyclicValue if the value refers
+// to itself through pointers.
+//
+// Example usage:
+//
+//	type User struct {
+//		Name     string
+//		Password string
+//	}
+//
+//	v, _ := kind.Redact(User{"Bob", "secret"}, func(path string, k *kind.Kind) bool {
+//		return k.IsString() && strings.HasSuffix(path, "Password")
+//	})
+//	fmt.Println(v) // {Bob }
+func Redact(v interface{}, rule func(path string, k *Kind) bool) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	r, err := redact(reflect.ValueOf(v), "", rule, map[uintptr]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Interface(), nil
+}
+
+// redact returns the copy of the value, or its zero
+// value if the node is selected by the rule.
+func redact(
+	v reflect.Value,
+	path string,
+	rule func(string, *Kind) bool,
+	seen map[uintptr]bool,
+) (reflect.Value, error) {
+	if rule != nil && rule(path, valueKind(v)) {
+		return reflect.Zero(v.Type()), nil
+	}
+
+	return redactChildren(v, path, rule, seen)
+}
+
+// copyValue returns a deep copy of the value, which is copied
+// the same way as by Redact.
+func copyValue(v reflect.Value, path string) (reflect.Value, error) {
+	return redactChildren(v, path, nil, map[uintptr]bool{})
+}
+
+// redactChildren returns the copy of the value with the children
+// redacted; the value itself is not checked by the rule.
+func redactChildren(
+	v reflect.Value,
+	path string,
+	rule func(string, *Kind) bool,
+	seen map[uintptr]bool,
+) (reflect.Value, error) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v, nil
+		}
+
+		if seen[v.Pointer()] {
+			return v, fmt.Errorf("%w: %s", ErrCyclicValue, path)
+		}
+
+		seen[v.Pointer()] = true
+		defer delete(seen, v.Pointer())
+
+		e, err := redactChildren(v.Elem(), path, rule, seen)
+		if err != nil {
+			return v, err
+		}
+
+		r := reflect.New(v.Type().Elem())
+		r.Elem().Set(e)
+		return r, nil
+	case reflect.Interface:
+		if v.IsNil() {
+			return v, nil
+		}
+
+		e, err := redactChildren(v.Elem(), path, rule, seen)
+		if err != nil {
+			return v, err
+		}
+
+		r := reflect.New(v.Type()).Elem()
+		r.Set(e)
+		return r, nil
+	case reflect.Struct:
+		r := reflect.New(v.Type()).Elem()
+		r.Set(v)
+
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+
+			f, err := redact(v.Field(i), fieldPath(path, t.Field(i).Name),
+				rule, seen)
+			if err != nil {
+				return v, err
+			}
+
+			r.Field(i).Set(f)
+		}
+
+		return r, nil
+	case reflect.Slice, reflect.Array:
+		var r reflect.Value
+		if v.Kind() == reflect.Array {
+			r = reflect.New(v.Type()).Elem()
+		} else if v.IsNil() {
+			return v, nil
+		} else {
+			r = reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			e, err := redact(v.Index(i), indexPath(path, i), rule, seen)
+			if err != nil {
+				return v, err
+			}
+
+			r.Index(i).Set(e)
+		}
+
+		return r, nil
+	case reflect.Map:
+		if v.IsNil() {
+			return v, nil
+		}
+
+		r := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, key := range sortedKeys(v) {
+			e, err := redact(v.MapIndex(key), keyPath(path, key), rule, seen)
+			if err != nil {
+				return v, err
+			}
+
+			r.SetMapIndex(key, e)
+		}
+
+		return r, nil
+	}
+
+	return v, nil
+}
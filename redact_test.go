@@ -0,0 +1,109 @@
+package kind
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestRedact tests the Redact function.
+func TestRedact(t *testing.T) {
+	type Account struct {
+		Login    string
+		Password string
+		secret   string
+	}
+
+	type User struct {
+		Name     string
+		Accounts []*Account
+		Meta     map[string]interface{}
+		Token    *string
+	}
+
+	token := "token"
+	password := func(path string, k *Kind) bool {
+		return k.IsString() && strings.Contains(strings.ToLower(path), "password")
+	}
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		rule     func(string, *Kind) bool
+		expected interface{}
+	}{
+		{
+			name: "nested passwords",
+			input: User{
+				Name:     "Bob",
+				Accounts: []*Account{{"bob", "123", "s"}},
+				Meta:     map[string]interface{}{"password": "x", "age": 3},
+			},
+			rule: password,
+			expected: User{
+				Name:     "Bob",
+				Accounts: []*Account{{"bob", "", "s"}},
+				Meta:     map[string]interface{}{"password": nil, "age": 3},
+			},
+		},
+		{
+			name:  "whole node",
+			input: &User{Name: "Bob", Token: &token},
+			rule: func(path string, k *Kind) bool {
+				return path == "Token"
+			},
+			expected: &User{Name: "Bob"},
+		},
+		{
+			name:     "nil",
+			input:    nil,
+			rule:     password,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Redact(tt.input, tt.rule)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %+v, but got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestRedactCopy tests that Redact does not modify the original value.
+func TestRedactCopy(t *testing.T) {
+	type Node struct {
+		Value string
+		Next  *Node
+	}
+
+	original := &Node{Value: "a", Next: &Node{Value: "b"}}
+	got, err := Redact(original, func(path string, k *Kind) bool {
+		return path == "Next.Value"
+	})
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if original.Next.Value != "b" {
+		t.Errorf("Original value was modified")
+	}
+
+	if got.(*Node).Next.Value != "" || got.(*Node).Next == original.Next {
+		t.Errorf("Expected a redacted copy, but got %+v", got.(*Node).Next)
+	}
+
+	original.Next.Next = original
+	_, err = Redact(original, func(string, *Kind) bool { return false })
+	if !errors.Is(err, ErrCyclicValue) {
+		t.Errorf("Expected ErrCyclicValue, but got %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package kind
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// The registry maps names of registered types to their types.
+var registry sync.Map
+
+// The aliases maps the normalized names registered by RegisterAlias
+// to their match functions.
+var aliases sync.Map
+
+// Register registers the type of the value, so Parse and TypeArgs can
+// resolve it by name. The type is registered under its name as printed
+// by Name, e.g. "main.User", and under its name qualified by the full
+// package path, e.g. "example.com/app.User".
+//
+// Example usage:
+//
+//	kind.Register(User{})
+//	k, _ := kind.Parse("[]*main.User")
+func Register(v interface{}) {
+	if v == nil {
+		panic("kind: attempt to register nil value")
+	}
+
+	t := reflect.TypeOf(v)
+	RegisterName(t.String(), v)
+	if name := canonicalName(t); name != t.String() {
+		RegisterName(name, v)
+	}
+}
+
+// RegisterName registers the type of the value under the given name,
+// so Parse and TypeArgs can resolve it by name. It panics if the name
+// is empty, the value is nil or the name is already registered with
+// a different type. Registering the same type twice is allowed.
+//
+// Example usage:
+//
+//	kind.RegisterName("User", User{})
+//	k, _ := kind.Parse("map[string][]*User")
+func RegisterName(name string, v interface{}) {
+	if name == "" {
+		panic("kind: attempt to register empty name")
+	}
+
+	if v == nil {
+		panic("kind: attempt to register nil value")
+	}
+
+	t := reflect.TypeOf(v)
+	if old, loaded := registry.LoadOrStore(name, t); loaded && old != t {
+		panic(fmt.Sprintf("kind: registering duplicate types for %q: %s != %s",
+			name, old, t))
+	}
+}
+
+// RegisterAlias registers the name as an alias for the Is method, which
+// then returns the result of match for the name, so domain vocabularies,
+// like "number", "text" or "list", can be used in the checks driven by
+// configuration files. The names are normalized like in Is. It panics if
+// the name is empty or predeclared, or match is nil. Registering the
+// name again replaces its match function.
+//
+// Example usage:
+//
+//	kind.RegisterAlias("integer", func(k *kind.Kind) bool {
+//		return k.IsInt() || k.IsInt8() || k.IsInt16() ||
+//			k.IsInt32() || k.IsInt64()
+//	})
+//	fmt.Println(kind.Of(int16(1)).Is("integer")) // true
+func RegisterAlias(name string, match func(*Kind) bool) {
+	key := strings.ToLower(normalizeName(name))
+	if key == "" {
+		panic("kind: attempt to register empty alias")
+	}
+
+	if _, ok := predeclared[key]; ok {
+		panic(fmt.Sprintf("kind: attempt to register predeclared alias %q", name))
+	}
+
+	if match == nil {
+		panic("kind: attempt to register nil alias match")
+	}
+
+	aliases.Store(key, match)
+}
+
+// aliasMatch returns the match function registered for the name.
+func aliasMatch(name string) (func(*Kind) bool, bool) {
+	match, ok := aliases.Load(strings.ToLower(normalizeName(name)))
+	if !ok {
+		return nil, false
+	}
+
+	return match.(func(*Kind) bool), true
+}
+
+// registeredType returns the type registered under the name.
+func registeredType(name string) (reflect.Type, bool) {
+	t, ok := registry.Load(name)
+	if !ok {
+		return nil, false
+	}
+
+	return t.(reflect.Type), true
+}
@@ -0,0 +1,129 @@
+package kind
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestParse tests the Parse function with registered types.
+func TestParse(t *testing.T) {
+	type Account struct{ ID int }
+	RegisterName("Account", Account{})
+	Register(Field{})
+
+	tests := []struct {
+		input    string
+		expected string
+		valid    bool
+	}{
+		{"int", "int", true},
+		{"map[string][]*Account", "map[string][]*kind.Account", true},
+		{"kind.Field", "kind.Field", true},
+		{"[]github.com/goloop/kind.Field", "[]kind.Field", true},
+		{"chan Account", "chan kind.Account", true},
+		{"User", "", false},
+		{"[]", "", false},
+		{"[9223372036854775807][9223372036854775807]int64", "", false},
+		{"[4][9000000000000000000]byte", "", false},
+		{"[1000][1000]int64", "[1000][1000]int64", true},
+		{"chan [65536]byte", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			k, err := Parse(tt.input)
+			if !tt.valid {
+				if !errors.Is(err, ErrInvalidTypeName) {
+					t.Errorf("Expected ErrInvalidTypeName, but got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if k.Name() != tt.expected {
+				t.Errorf("Expected %q, but got %q", tt.expected, k.Name())
+			}
+		})
+	}
+
+	k, _ := Parse("map[string][]*Account")
+	if !k.IsMap() || !k.MapValueKind().IsSlice() ||
+		!k.MapValueKind().IsPointer() || !k.MapValueKind().IsStruct() {
+		t.Errorf("Expected a map of slices of pointers to structs")
+	}
+}
+
+// TestRegisterName tests the panics of the RegisterName function.
+func TestRegisterName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		value interface{}
+	}{
+		{"empty name", "", 1},
+		{"nil value", "nil", nil},
+		{"duplicate", "test.Int", "string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterName("test.Int", 0)
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected panic")
+				}
+			}()
+
+			RegisterName(tt.input, tt.value)
+		})
+	}
+}
+
+// TestRegisterAlias tests the kind.RegisterAlias function.
+func TestRegisterAlias(t *testing.T) {
+	RegisterAlias("test.Number", func(k *Kind) bool {
+		return k.IsInt() || k.IsInt64() || k.IsFloat64()
+	})
+	RegisterAlias("test.list", func(k *Kind) bool {
+		return k.IsSlice() || k.IsArray()
+	})
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		alias    string
+		expected bool
+	}{
+		{"int", 1, "test.Number", true},
+		{"float", 1.5, "test.number", true},
+		{"string", "1", "test.Number", false},
+		{"slice", []string{}, " test.List ", true},
+		{"map", map[string]int{}, "test.list", false},
+		{"unknown", 1, "test.unknown", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if is := Of(tt.input).Is(tt.alias); is != tt.expected {
+				t.Errorf("Expected %v for %q, but got %v",
+					tt.expected, tt.alias, is)
+			}
+		})
+	}
+
+	for _, name := range []string{"", "int", "Byte", "interface {}"} {
+		t.Run("panic "+name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected panic")
+				}
+			}()
+
+			RegisterAlias(name, func(*Kind) bool { return true })
+		})
+	}
+}
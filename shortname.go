@@ -0,0 +1,99 @@
+package kind
+
+import "strings"
+
+// ShortName returns the name of the Kind instance without the package
+// qualifiers of the named types in it, e.g. "map[string][]*Config" for
+// map[string][]*mypkg.Config. It is meant for messages to users and
+// generated identifiers; the short names of types from different
+// packages can collide.
+//
+// Example usage:
+//
+//	k := kind.Of(map[string][]*mypkg.Config{})
+//	fmt.Println(k.Name())      // "map[string][]*mypkg.Config"
+//	fmt.Println(k.ShortName()) // "map[string][]*Config"
+func (k *Kind) ShortName() string {
+	return shortName(k.name)
+}
+
+// Base returns the short name, see ShortName, of the element type of the
+// pointers, slices and arrays the Kind instance represents, e.g. "Config"
+// for []*mypkg.Config. For other types it is the same as ShortName.
+//
+// Example usage:
+//
+//	k := kind.Of([]*mypkg.Config{})
+//	fmt.Println(k.Base()) // "Config"
+func (k *Kind) Base() string {
+	name, _ := trimSequences(k.name)
+	return shortName(name)
+}
+
+// shortName returns the type name without the package qualifiers.
+// A qualifier is the part of an identifier up to the last dot, which
+// can also hold a package path, e.g. "example.com/app.User". Quoted
+// struct tags are kept as is.
+func shortName(name string) string {
+	if !strings.Contains(name, ".") {
+		return name
+	}
+
+	var b strings.Builder
+	b.Grow(len(name))
+	for i := 0; i < len(name); {
+		switch c := name[i]; {
+		case c == '"' || c == '`':
+			end := quoteEnd(name, i)
+			b.WriteString(name[i:end])
+			i = end
+		case isNameDelim(c):
+			b.WriteByte(c)
+			i++
+		default:
+			end := i
+			for end < len(name) && !isNameDelim(name[end]) &&
+				name[end] != '"' && name[end] != '`' {
+				end++
+			}
+
+			ident := name[i:end]
+			if strings.HasPrefix(ident, "...") {
+				b.WriteString("...")
+				ident = ident[3:]
+			}
+
+			if dot := strings.LastIndexByte(ident, '.'); dot >= 0 {
+				ident = ident[dot+1:]
+			}
+
+			b.WriteString(ident)
+			i = end
+		}
+	}
+
+	return b.String()
+}
+
+// isNameDelim returns true if the byte separates the identifiers
+// in a type name.
+func isNameDelim(c byte) bool {
+	return strings.IndexByte("[]*(),;{} ", c) >= 0
+}
+
+// quoteEnd returns the index after the quoted string that starts at i.
+func quoteEnd(name string, i int) int {
+	quote := name[i]
+	for j := i + 1; j < len(name); j++ {
+		switch name[j] {
+		case '\\':
+			if quote == '"' {
+				j++
+			}
+		case quote:
+			return j + 1
+		}
+	}
+
+	return len(name)
+}
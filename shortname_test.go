@@ -0,0 +1,61 @@
+package kind
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+// TestShortName tests the ShortName and Base methods.
+func TestShortName(t *testing.T) {
+	tests := []struct {
+		name  string
+		kind  *Kind
+		short string
+		base  string
+	}{
+		{"scalar", Of(1), "int", "int"},
+		{"nil", Of(nil), "nil", "nil"},
+		{"named", Of(time.Second), "Duration", "Duration"},
+		{"slice of pointers", Of([]*http.Request{}), "[]*Request", "Request"},
+		{"array", Of([2][]time.Time{}), "[2][]Time", "Time"},
+		{
+			"map",
+			Of(map[string][]*time.Location{}),
+			"map[string][]*Location",
+			"map[string][]*Location",
+		},
+		{
+			"func",
+			Of(func(...time.Duration) (*http.Client, error) { return nil, nil }),
+			"func(...Duration) (*Client, error)",
+			"func(...Duration) (*Client, error)",
+		},
+		{
+			"struct",
+			Of(struct {
+				T time.Time `json:"t.x"`
+			}{}),
+			"struct { T Time \"json:\\\"t.x\\\"\" }",
+			"struct { T Time \"json:\\\"t.x\\\"\" }",
+		},
+		{
+			"package path",
+			&Kind{typeNode: &typeNode{name: "[]example.com/app.Box[example.com/app.Item]"}},
+			"[]Box[Item]",
+			"Box[Item]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if short := tt.kind.ShortName(); short != tt.short {
+				t.Errorf("Expected short name %q, but got %q", tt.short, short)
+			}
+
+			if base := tt.kind.Base(); base != tt.base {
+				t.Errorf("Expected base %q, but got %q", tt.base, base)
+			}
+		})
+	}
+}
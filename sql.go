@@ -0,0 +1,132 @@
+package kind
+
+import (
+	"database/sql/driver"
+	"reflect"
+)
+
+// The sqlScanner mirrors the sql.Scanner interface, so the database/sql
+// package with its driver registry is not linked into every binary
+// using this package.
+type sqlScanner interface {
+	Scan(src interface{}) error
+}
+
+var (
+	sqlScannerType = reflect.TypeOf((*sqlScanner)(nil)).Elem()
+	sqlValuerType  = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+)
+
+// IsSQLScanner returns true if a pointer to the type represented by the
+// Kind instance implements sql.Scanner, so it can be passed to Rows.Scan
+// directly. Scanning always needs a pointer, so the pointer method set
+// is checked.
+//
+// Example usage:
+//
+//	k := kind.Of(sql.NullString{})
+//	fmt.Println(k.IsSQLScanner()) // true
+func (k *Kind) IsSQLScanner() bool {
+	return implementsByPointer(k.rtype, sqlScannerType)
+}
+
+// IsSQLValuer returns true if the type represented by the Kind instance
+// implements driver.Valuer, so it can be passed to database/sql as
+// a query argument directly.
+//
+// Example usage:
+//
+//	k := kind.Of(sql.NullString{})
+//	fmt.Println(k.IsSQLValuer()) // true
+func (k *Kind) IsSQLValuer() bool {
+	return k.rtype != nil && k.rtype.Implements(sqlValuerType)
+}
+
+// IsNullWrapper returns true if the Kind instance represents a nullable
+// wrapper in the style of database/sql, such as sql.NullString or
+// sql.NullInt64, or a pointer to one. A wrapper is recognized by its
+// shape: a struct of the wrapped value followed by a Valid bool field,
+// which implements both sql.Scanner and driver.Valuer.
+//
+// Example usage:
+//
+//	fmt.Println(kind.Of(sql.NullString{}).IsNullWrapper()) // true
+func (k *Kind) IsNullWrapper() bool {
+	return nullWrapperType(k.rtype) != nil
+}
+
+// NullWrapped returns the Kind instance of the value wrapped by a
+// nullable wrapper, e.g. the string kind for sql.NullString. It returns
+// a nil Kind if the Kind instance is not a wrapper, see IsNullWrapper.
+//
+// Example usage:
+//
+//	k := kind.Of(sql.NullTime{})
+//	fmt.Println(k.NullWrapped().Name()) // "time.Time"
+func (k *Kind) NullWrapped() *Kind {
+	t := nullWrapperType(k.rtype)
+	if t == nil {
+		return &Kind{typeNode: nilNode}
+	}
+
+	return ofType(t.Field(0).Type)
+}
+
+// NullValid returns the Valid field of the nullable wrapper stored in
+// the Kind instance, and true. It returns false as the second value if
+// the Kind instance is not a wrapper, has no value, e.g. is created by
+// For or OfType, or stores a nil pointer.
+//
+// Example usage:
+//
+//	k := kind.Of(sql.NullInt64{Int64: 7, Valid: true})
+//	valid, ok := k.NullValid()
+//	fmt.Println(valid, ok) // true true
+func (k *Kind) NullValid() (bool, bool) {
+	if nullWrapperType(k.rtype) == nil {
+		return false, false
+	}
+
+	v := reflect.ValueOf(k.value)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return false, false
+		}
+
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return false, false
+	}
+
+	return v.Field(1).Bool(), true
+}
+
+// nullWrapperType returns the wrapper struct type if the type is
+// a nullable wrapper or a pointer to one, or nil otherwise.
+func nullWrapperType(t reflect.Type) reflect.Type {
+	if t == nil {
+		return nil
+	}
+
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || t.NumField() != 2 {
+		return nil
+	}
+
+	valid := t.Field(1)
+	if valid.Name != "Valid" || valid.Type.Kind() != reflect.Bool {
+		return nil
+	}
+
+	if !t.Implements(sqlValuerType) ||
+		!reflect.PtrTo(t).Implements(sqlScannerType) {
+		return nil
+	}
+
+	return t
+}
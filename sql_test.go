@@ -0,0 +1,100 @@
+package kind
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Ensure the local interface matches the sql one.
+var _ sqlScanner = sql.Scanner(nil)
+
+// TestSQL tests the Kind.IsSQLScanner and Kind.IsSQLValuer methods.
+func TestSQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		scanner bool
+		valuer  bool
+	}{
+		{"null string", sql.NullString{}, true, true},
+		{"null string pointer", &sql.NullInt64{}, true, true},
+		{"raw bytes", sql.RawBytes{}, false, false},
+		{"time", time.Time{}, false, false},
+		{"string", "test", false, false},
+		{"nil", nil, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := Of(tt.input)
+			if k.IsSQLScanner() != tt.scanner {
+				t.Errorf("Expected scanner %v, but got %v",
+					tt.scanner, k.IsSQLScanner())
+			}
+
+			if k.IsSQLValuer() != tt.valuer {
+				t.Errorf("Expected valuer %v, but got %v",
+					tt.valuer, k.IsSQLValuer())
+			}
+		})
+	}
+}
+
+// TestNullWrapper tests the Kind.IsNullWrapper, Kind.NullWrapped and
+// Kind.NullValid methods.
+func TestNullWrapper(t *testing.T) {
+	type fake struct {
+		String string
+		Valid  bool
+	}
+
+	tests := []struct {
+		name    string
+		input   interface{}
+		wrapper bool
+		wrapped string
+		valid   bool
+		ok      bool
+	}{
+		{"null string", sql.NullString{}, true, "string", false, true},
+		{"null int64", sql.NullInt64{Int64: 7, Valid: true}, true, "int64", true, true},
+		{"null bool", &sql.NullBool{Valid: true}, true, "bool", true, true},
+		{"null time", sql.NullTime{}, true, "time.Time", false, true},
+		{"nil pointer", (*sql.NullFloat64)(nil), true, "float64", false, false},
+		{"same shape", fake{}, false, "nil", false, false},
+		{"string", "test", false, "nil", false, false},
+		{"nil", nil, false, "nil", false, false},
+		{"no value", For[sql.NullString](), true, "string", false, false},
+		{
+			"no value pointer",
+			OfType(reflect.TypeOf(&sql.NullInt64{})),
+			true, "int64", false, false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, ok := tt.input.(*Kind)
+			if !ok {
+				k = Of(tt.input)
+			}
+
+			if k.IsNullWrapper() != tt.wrapper {
+				t.Errorf("Expected wrapper %v, but got %v",
+					tt.wrapper, k.IsNullWrapper())
+			}
+
+			if name := k.NullWrapped().Name(); name != tt.wrapped {
+				t.Errorf("Expected wrapped %q, but got %q", tt.wrapped, name)
+			}
+
+			valid, ok := k.NullValid()
+			if valid != tt.valid || ok != tt.ok {
+				t.Errorf("Expected valid %v %v, but got %v %v",
+					tt.valid, tt.ok, valid, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package kind
+
+import "reflect"
+
+// Stats returns the number of values per kind name.
+//
+// Example usage:
+//
+//	stats := kind.Stats([]interface{}{1, 2, "three", nil})
+//	fmt.Println(stats) // map[int:2 nil:1 string:1]
+func Stats(values []interface{}) map[string]int {
+	result := make(map[string]int)
+	for _, v := range values {
+		result[Of(v).Name()]++
+	}
+
+	return result
+}
+
+// GroupBy groups values by their kind name. The order of values
+// inside each group is the same as in the source slice.
+//
+// Example usage:
+//
+//	groups := kind.GroupBy([]interface{}{1, "two", 3})
+//	fmt.Println(groups["int"]) // [1 3]
+func GroupBy(values []interface{}) map[string][]interface{} {
+	result := make(map[string][]interface{})
+	for _, v := range values {
+		name := Of(v).Name()
+		result[name] = append(result[name], v)
+	}
+
+	return result
+}
+
+// Homogeneous reports whether all values share a single type and
+// returns the Kind of the first value if they do. The types are compared
+// themselves, not by name, so distinct types with the same name, e.g.
+// declared in different packages, are a mix. For an empty slice or
+// a mix of types it returns nil and false.
+//
+// Example usage:
+//
+//	k, ok := kind.Homogeneous([]interface{}{1, 2, 3})
+//	fmt.Println(k.Name(), ok) // "int" true
+//
+//	_, ok = kind.Homogeneous([]interface{}{1, "two"})
+//	fmt.Println(ok) // false
+func Homogeneous(values []interface{}) (*Kind, bool) {
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	t := reflect.TypeOf(values[0])
+	for _, v := range values[1:] {
+		if reflect.TypeOf(v) != t {
+			return nil, false
+		}
+	}
+
+	return Of(values[0]), true
+}
@@ -0,0 +1,73 @@
+package kind
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestStats tests the kind.Stats function.
+func TestStats(t *testing.T) {
+	values := []interface{}{1, 2, "three", nil, []int{4}, 5}
+	expected := map[string]int{"int": 3, "string": 1, "nil": 1, "[]int": 1}
+
+	if result := Stats(values); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+
+	if result := Stats(nil); len(result) != 0 {
+		t.Errorf("Expected empty stats, but got %v", result)
+	}
+}
+
+// TestGroupBy tests the kind.GroupBy function.
+func TestGroupBy(t *testing.T) {
+	values := []interface{}{1, "two", 3, true, "four"}
+	expected := map[string][]interface{}{
+		"int":    {1, 3},
+		"string": {"two", "four"},
+		"bool":   {true},
+	}
+
+	if result := GroupBy(values); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, but got %v", expected, result)
+	}
+}
+
+// TestHomogeneous tests the kind.Homogeneous function.
+func TestHomogeneous(t *testing.T) {
+	type User struct{ Name string }
+	other := func() interface{} {
+		type User struct{ ID int }
+		return User{}
+	}()
+
+	tests := []struct {
+		name   string
+		values []interface{}
+		kind   string
+		ok     bool
+	}{
+		{"ints", []interface{}{1, 2, 3}, "int", true},
+		{"single", []interface{}{"one"}, "string", true},
+		{"mixed", []interface{}{1, "two"}, "", false},
+		{"mixed ints", []interface{}{1, int64(2)}, "", false},
+		{"empty", []interface{}{}, "", false},
+		{"nils", []interface{}{nil, nil}, "nil", true},
+		{"structs", []interface{}{User{"a"}, User{"b"}}, "kind.User", true},
+		{"same names", []interface{}{User{}, other}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, ok := Homogeneous(tt.values)
+			if ok != tt.ok {
+				t.Fatalf("Expected %v, but got %v", tt.ok, ok)
+			}
+
+			if ok && k.Name() != tt.kind {
+				t.Errorf("Expected type name %s, but got %s",
+					tt.kind, k.Name())
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package kind
+
+// TemplateFuncs returns the functions for text/template and html/template
+// that let templates rendering arbitrary data branch on kinds. Each
+// function takes a value, or a *Kind to use as is:
+//
+//	isSlice   IsSlice of the Kind
+//	isMap     IsMap of the Kind
+//	kindName  Name of the Kind
+//	fields    Fields of the Kind
+//	elemKind  ElemKind of the Kind
+//
+// The result can be passed to the Funcs method of both template packages
+// without importing either of them here.
+//
+// Example usage:
+//
+//	t := template.Must(template.New("doc").Funcs(kind.TemplateFuncs()).Parse(
+//		`{{range fields .}}{{.Name}}: {{kindName .Kind}}{{"\n"}}{{end}}`))
+//	t.Execute(os.Stdout, User{})
+//	// Name: string
+//	// Age: int
+func TemplateFuncs() map[string]interface{} {
+	return map[string]interface{}{
+		"isSlice":  func(v interface{}) bool { return templateKind(v).IsSlice() },
+		"isMap":    func(v interface{}) bool { return templateKind(v).IsMap() },
+		"kindName": func(v interface{}) string { return templateKind(v).Name() },
+		"fields":   func(v interface{}) []Field { return templateKind(v).Fields() },
+		"elemKind": func(v interface{}) *Kind { return templateKind(v).ElemKind() },
+	}
+}
+
+// templateKind returns the Kind of the template argument,
+// which is the argument itself if it is a Kind.
+func templateKind(v interface{}) *Kind {
+	if k, ok := v.(*Kind); ok && k != nil {
+		return k
+	}
+
+	return Of(v)
+}
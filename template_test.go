@@ -0,0 +1,77 @@
+package kind
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+// TestTemplateFuncs tests the kind.TemplateFuncs function.
+func TestTemplateFuncs(t *testing.T) {
+	type User struct {
+		Name string
+		Tags []string
+	}
+
+	tests := []struct {
+		name     string
+		text     string
+		data     interface{}
+		expected string
+	}{
+		{
+			"fields",
+			`{{range fields .}}{{.Name}}:{{kindName .Kind}};{{end}}`,
+			User{},
+			"Name:string;Tags:[]string;",
+		},
+		{
+			"branch",
+			`{{if isSlice .}}slice{{else if isMap .}}map{{else}}other{{end}}`,
+			map[string]int{},
+			"map",
+		},
+		{
+			"kind argument",
+			`{{range fields .}}{{if isSlice .Kind}}{{.Name}}{{end}}{{end}}`,
+			&User{},
+			"Tags",
+		},
+		{
+			"elem",
+			`{{kindName (elemKind .)}} {{isSlice (elemKind .)}}`,
+			[][]int{},
+			"[]int true",
+		},
+		{"nil", `{{kindName .}} {{len (fields .)}}`, nil, "nil 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := template.Must(template.New(tt.name).
+				Funcs(TemplateFuncs()).Parse(tt.text))
+
+			var b strings.Builder
+			if err := tmpl.Execute(&b, tt.data); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if b.String() != tt.expected {
+				t.Errorf("Expected %q, but got %q", tt.expected, b.String())
+			}
+		})
+	}
+
+	html := htmltemplate.Must(htmltemplate.New("html").
+		Funcs(TemplateFuncs()).Parse(`<b>{{kindName .}}</b>`))
+
+	var b strings.Builder
+	if err := html.Execute(&b, []int{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if b.String() != "<b>[]int</b>" {
+		t.Errorf("Expected <b>[]int</b>, but got %q", b.String())
+	}
+}
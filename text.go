@@ -0,0 +1,63 @@
+package kind
+
+import "reflect"
+
+// IsTextLike returns true if the Kind instance represents a string,
+// a byte slice or a rune slice, including named types of them.
+func (k *Kind) IsTextLike() bool {
+	return textType(k.rtype)
+}
+
+// Text returns the stored text-like value as a string, converting
+// a byte slice or a rune slice. It returns false if the Kind instance
+// is not text-like or has no value.
+//
+// Example usage:
+//
+//	for _, v := range []interface{}{"go", []byte("go"), []rune("go")} {
+//		s, _ := kind.Of(v).Text()
+//		fmt.Println(s) // "go"
+//	}
+func (k *Kind) Text() (string, bool) {
+	if !k.IsTextLike() || k.value == nil {
+		return "", false
+	}
+
+	rv := reflect.ValueOf(k.value)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), true
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return string(rv.Bytes()), true
+		}
+
+		runes := make([]rune, rv.Len())
+		for i := range runes {
+			runes[i] = rune(rv.Index(i).Int())
+		}
+
+		return string(runes), true
+	}
+
+	return "", false
+}
+
+// textType returns true if the type is a string, byte slice or rune slice.
+func textType(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return true
+	case reflect.Slice:
+		switch t.Elem().Kind() {
+		case reflect.Uint8, reflect.Int32:
+			return true
+		}
+	}
+
+	return false
+}
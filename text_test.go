@@ -0,0 +1,46 @@
+package kind
+
+import "testing"
+
+// TestText tests the IsTextLike and Text methods.
+func TestText(t *testing.T) {
+	type Name string
+	type Bytes []byte
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		textLike bool
+		text     string
+		ok       bool
+	}{
+		{"string", "go", true, "go", true},
+		{"named string", Name("go"), true, "go", true},
+		{"bytes", []byte("go"), true, "go", true},
+		{"named bytes", Bytes("go"), true, "go", true},
+		{"runes", []rune("gö"), true, "gö", true},
+		{"nil bytes", []byte(nil), true, "", true},
+		{"ints", []int{1}, false, "", false},
+		{"byte array", [2]byte{'g', 'o'}, false, "", false},
+		{"pointer", new(string), false, "", false},
+		{"nil", nil, false, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := Of(tt.input)
+			if r := k.IsTextLike(); r != tt.textLike {
+				t.Errorf("Expected text-like %v, but got %v", tt.textLike, r)
+			}
+
+			if s, ok := k.Text(); s != tt.text || ok != tt.ok {
+				t.Errorf("Expected %q %v, but got %q %v",
+					tt.text, tt.ok, s, ok)
+			}
+		})
+	}
+
+	if _, ok := For[[]byte]().Text(); ok {
+		t.Error("Expected no text without a value")
+	}
+}
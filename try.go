@@ -0,0 +1,154 @@
+package kind
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrNestedSequence is returned when the type contains sequences
+// nested deeper than the Kind flags can describe, e.g. [][][]int.
+var ErrNestedSequence = errors.New("kind: sequence nested too deeply")
+
+// TryOf returns a Kind instance that represents the type of the given
+// value, or an error if the type cannot be fully represented. Unlike Of,
+// which silently produces a partial Kind for such types, TryOf reports
+// the failure.
+//
+// Example usage:
+//
+//	k, err := kind.TryOf([]int{1, 2, 3})
+//	fmt.Println(k.Name(), err) // "[]int" <nil>
+//
+//	_, err = kind.TryOf([][][]int{})
+//	fmt.Println(errors.Is(err, kind.ErrNestedSequence)) // true
+func TryOf(v interface{}) (*Kind, error) {
+	if v != nil {
+		if err := checkSupport(reflect.TypeOf(v)); err != nil {
+			return nil, err
+		}
+	}
+
+	return Of(v), nil
+}
+
+// MustOf is like TryOf but panics if the type cannot be fully
+// represented. It simplifies the initialization of package-level
+// variables holding Kind descriptors.
+//
+// Example usage:
+//
+//	var userKind = kind.MustOf(User{})
+func MustOf(v interface{}) *Kind {
+	k, err := TryOf(v)
+	if err != nil {
+		panic(fmt.Sprintf("kind: MustOf(%T): %v", v, err))
+	}
+
+	return k
+}
+
+// MustFor is like For but panics if the type parameter cannot be fully
+// represented, see TryOf.
+//
+// Example usage:
+//
+//	var handlerKind = kind.MustFor[http.Handler]()
+func MustFor[T any]() *Kind {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if err := checkSupport(t); err != nil {
+		panic(fmt.Sprintf("kind: MustFor[%s]: %v", t, err))
+	}
+
+	return ofType(t)
+}
+
+// checkSupport returns an error if the type cannot be fully represented
+// by the Kind flags. It follows the same path as checkComplexTypes.
+func checkSupport(t reflect.Type) error {
+	return checkTypeSupport(t, nil)
+}
+
+// checkTypeSupport is checkSupport for the types of maps, channels and
+// iterators nested in the checked types, which are not checked again
+// when a type refers back to them, e.g. type M map[string]M.
+func checkTypeSupport(t reflect.Type, checked map[reflect.Type]bool) error {
+	root := t
+	levels := 0   // number of sequence levels
+	adjacent := 0 // number of sequence levels directly nested in another
+	prevSequence := false
+	var mark reflect.Type // type of the repeat check, see checkComplexTypes
+	for step := 0; ; step++ {
+		if t == mark {
+			// The chain leads back to itself, e.g. type A []A,
+			// so its sequences are nested without end.
+			if levels > 0 {
+				return fmt.Errorf("%w: %s", ErrNestedSequence, root)
+			}
+
+			return nil
+		}
+
+		if step&(step-1) == 0 {
+			mark = t
+		}
+
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array:
+			levels++
+			if prevSequence {
+				adjacent++
+			}
+
+			prevSequence = true
+			t = t.Elem()
+			continue
+		case reflect.Ptr:
+			prevSequence = false
+			t = t.Elem()
+			continue
+		case reflect.Chan, reflect.Map, reflect.Func:
+			if checked[t] {
+				return nil
+			}
+
+			if checked == nil {
+				checked = map[reflect.Type]bool{}
+			}
+
+			checked[t] = true
+		}
+
+		switch t.Kind() {
+		case reflect.Chan:
+			if err := checkTypeSupport(t.Elem(), checked); err != nil {
+				return err
+			}
+		case reflect.Map:
+			if err := checkTypeSupport(t.Key(), checked); err != nil {
+				return err
+			}
+
+			if err := checkTypeSupport(t.Elem(), checked); err != nil {
+				return err
+			}
+		case reflect.Func:
+			yield := seqYield(t)
+			for i := 0; yield != nil && i < yield.NumIn(); i++ {
+				if err := checkTypeSupport(yield.In(i), checked); err != nil {
+					return err
+				}
+			}
+		}
+
+		break
+	}
+
+	// Only a single sequence or two directly nested sequences
+	// (slice of slices, array of arrays, etc.) have flags.
+	if levels > 2 || (levels == 2 && adjacent == 0) {
+		return fmt.Errorf("%w: %s", ErrNestedSequence, root)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package kind
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+// TestTryOf tests the kind.TryOf function for supported types.
+func TestTryOf(t *testing.T) {
+	n := 1
+	tests := []interface{}{
+		nil, 1, "test", &n, []int{}, [][]int{}, [2][]int{},
+		map[string][]int{}, map[string][][]int{}, make(chan []int),
+		struct{ F func() }{}, []*int{}, map[string]interface{}{},
+		func(yield func(int, string) bool) {},
+		unsafe.Pointer(&n), []unsafe.Pointer{}, func() {}, []func(){},
+		func(yield func(func()) bool) {},
+		selfPointer(nil),
+	}
+
+	for _, v := range tests {
+		k, err := TryOf(v)
+		if err != nil {
+			t.Errorf("Unexpected error for %T: %v", v, err)
+			continue
+		}
+
+		if ok, result := deepEqualKind(Of(v), k); !ok {
+			t.Errorf("DeepEqual expected kind %+v, but got %+v:\n%s",
+				Of(v), k, result)
+		}
+	}
+}
+
+// TestTryOfErrors tests the kind.TryOf function for unsupported types.
+func TestTryOfErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+		err   error
+	}{
+		{"iterator", func(yield func([][][]int) bool) {}, ErrNestedSequence},
+		{"channel", make(chan [][][]int), ErrNestedSequence},
+		{"three levels", [][][]int{}, ErrNestedSequence},
+		{"separated levels", []*[]int{}, ErrNestedSequence},
+		{"nested map value", map[int][][][2]int{}, ErrNestedSequence},
+		{"self-referential slice", selfSlice{}, ErrNestedSequence},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := TryOf(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Expected %v, but got %v", tt.err, err)
+			}
+
+			if k != nil {
+				t.Errorf("Expected nil kind, but got %s", k)
+			}
+		})
+	}
+}
+
+// TestMust tests the kind.MustOf, kind.MustFor and kind.MustParse
+// functions.
+func TestMust(t *testing.T) {
+	mustPanic := func(name, message string, fn func()) {
+		t.Helper()
+		defer func() {
+			r := recover()
+			if s, _ := r.(string); !strings.Contains(s, message) {
+				t.Errorf("%s: expected panic with %q, but got %v",
+					name, message, r)
+			}
+		}()
+
+		fn()
+	}
+
+	if k := MustOf([]int{1}); !k.IsSlice() || !k.IsInt() {
+		t.Errorf("Expected []int, but got %s", k)
+	}
+
+	if k := MustFor[map[string]int](); !k.IsMap() {
+		t.Errorf("Expected map[string]int, but got %s", k)
+	}
+
+	if k := MustParse("[]*int"); k.Name() != "[]*int" {
+		t.Errorf("Expected []*int, but got %s", k)
+	}
+
+	mustPanic("MustOf", "MustOf([][][]int)", func() { MustOf([][][]int{}) })
+	mustPanic("MustFor", "MustFor[[][][]int]", func() { MustFor[[][][]int]() })
+	mustPanic("MustParse", `MustParse("map[")`, func() { MustParse("map[") })
+}
@@ -0,0 +1,284 @@
+package kind
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// ErrInvalidTypeName is returned when a type name cannot be
+// parsed or resolved.
+var ErrInvalidTypeName = errors.New("kind: invalid type name")
+
+// The errTypeLimit wraps the ErrInvalidTypeName errors of the type names
+// that are well-formed but denote types that cannot be built, such as
+// arrays too large for the address space. Unlike unknown names, they
+// only come from corrupt or crafted input.
+var errTypeLimit = errors.New("type exceeds the limits")
+
+// The predeclared maps names of the predeclared types to their types.
+var predeclared = map[string]reflect.Type{
+	"bool":           reflect.TypeOf(false),
+	"string":         reflect.TypeOf(""),
+	"int":            reflect.TypeOf(int(0)),
+	"int8":           reflect.TypeOf(int8(0)),
+	"int16":          reflect.TypeOf(int16(0)),
+	"int32":          reflect.TypeOf(int32(0)),
+	"int64":          reflect.TypeOf(int64(0)),
+	"uint":           reflect.TypeOf(uint(0)),
+	"uint8":          reflect.TypeOf(uint8(0)),
+	"uint16":         reflect.TypeOf(uint16(0)),
+	"uint32":         reflect.TypeOf(uint32(0)),
+	"uint64":         reflect.TypeOf(uint64(0)),
+	"uintptr":        reflect.TypeOf(uintptr(0)),
+	"float32":        reflect.TypeOf(float32(0)),
+	"float64":        reflect.TypeOf(float64(0)),
+	"complex64":      reflect.TypeOf(complex64(0)),
+	"complex128":     reflect.TypeOf(complex128(0)),
+	"byte":           reflect.TypeOf(byte(0)),
+	"rune":           reflect.TypeOf(rune(0)),
+	"error":          reflect.TypeOf((*error)(nil)).Elem(),
+	"any":            reflect.TypeOf((*interface{})(nil)).Elem(),
+	"interface {}":   reflect.TypeOf((*interface{})(nil)).Elem(),
+	"interface{}":    reflect.TypeOf((*interface{})(nil)).Elem(),
+	"struct {}":      reflect.TypeOf(struct{}{}),
+	"struct{}":       reflect.TypeOf(struct{}{}),
+	"unsafe.Pointer": reflect.TypeOf(unsafe.Pointer(nil)),
+}
+
+// Parse parses the Go type expression, such as "map[string][]*User", and
+// returns the Kind instance of the type it denotes, without a value. It is
+// the inverse of Name. The expression can use the predeclared types,
+// the types registered by Register or RegisterName, and pointer, slice,
+// array, map and channel types built from them. It returns
+// ErrInvalidTypeName if the expression cannot be parsed or refers
+// to an unknown type.
+//
+// Example usage:
+//
+//	kind.RegisterName("User", User{})
+//
+//	k, err := kind.Parse("map[string][]*User")
+//	fmt.Println(k.IsMap(), k.MapValueKind().Name(), err)
+//	// true []*main.User <nil>
+func Parse(name string) (*Kind, error) {
+	t, err := parseTypeName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return ofType(t), nil
+}
+
+// MustParse is like Parse but panics if the expression cannot be parsed.
+// It simplifies the initialization of package-level variables holding
+// Kind descriptors.
+//
+// Example usage:
+//
+//	var tagsKind = kind.MustParse("map[string][]string")
+func MustParse(name string) *Kind {
+	k, err := Parse(name)
+	if err != nil {
+		panic(fmt.Sprintf("kind: MustParse(%q): %v", name, err))
+	}
+
+	return k
+}
+
+// parseTypeName parses the Go type expression, such as "map[string][]int",
+// and returns the type it denotes. Only predeclared types, registered
+// types and composite types built from them are supported.
+func parseTypeName(name string) (reflect.Type, error) {
+	p := &typeParser{src: name}
+	t, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.skipSpace(); p.pos != len(p.src) {
+		return nil, p.errorf("unexpected %q", p.src[p.pos:])
+	}
+
+	return t, nil
+}
+
+// The maxTypeNameDepth is the maximum nesting of the type expressions
+// accepted by the parser, which protects its stack from crafted input.
+const maxTypeNameDepth = 100
+
+// The maxChanElemSize is the size of the smallest element type
+// the runtime does not allow in channels.
+const maxChanElemSize = 1 << 16
+
+// The typeParser is a recursive descent parser of type expressions.
+type typeParser struct {
+	src   string // source expression
+	pos   int    // current position in src
+	depth int    // nesting of the expression being parsed
+}
+
+// parse parses the type expression at the current position.
+func (p *typeParser) parse() (reflect.Type, error) {
+	if p.depth++; p.depth > maxTypeNameDepth {
+		return nil, p.limitf("type is nested too deeply")
+	}
+
+	defer func() { p.depth-- }()
+
+	p.skipSpace()
+	switch {
+	case p.consume("*"):
+		elem, err := p.parse()
+		if err != nil {
+			return nil, err
+		}
+
+		return reflect.PtrTo(elem), nil
+	case p.consume("[]"):
+		elem, err := p.parse()
+		if err != nil {
+			return nil, err
+		}
+
+		return reflect.SliceOf(elem), nil
+	case p.consume("["):
+		end := strings.IndexByte(p.src[p.pos:], ']')
+		if end < 0 {
+			return nil, p.errorf("missing ]")
+		}
+
+		n, err := strconv.Atoi(strings.TrimSpace(p.src[p.pos : p.pos+end]))
+		if err != nil || n < 0 {
+			return nil, p.errorf("invalid array length")
+		}
+
+		p.pos += end + 1
+		elem, err := p.parse()
+		if err != nil {
+			return nil, err
+		}
+
+		// The reflect.ArrayOf function panics if the size
+		// of the array overflows the address space.
+		if elem.Size() > 0 && uintptr(n) > ^uintptr(0)/elem.Size() {
+			return nil, p.limitf("array of %d %s is too large", n, elem)
+		}
+
+		return reflect.ArrayOf(n, elem), nil
+	case p.consume("map["):
+		key, err := p.parse()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.skipSpace(); !p.consume("]") {
+			return nil, p.errorf("missing ]")
+		}
+
+		elem, err := p.parse()
+		if err != nil {
+			return nil, err
+		}
+
+		if !key.Comparable() {
+			return nil, p.errorf("invalid map key type %s", key)
+		}
+
+		return reflect.MapOf(key, elem), nil
+	case p.consume("<-chan "):
+		return p.parseChan(reflect.RecvDir)
+	case p.consume("chan<- "):
+		return p.parseChan(reflect.SendDir)
+	case p.consume("chan "):
+		return p.parseChan(reflect.BothDir)
+	}
+
+	return p.parseName()
+}
+
+// parseChan parses the element type of the channel type.
+func (p *typeParser) parseChan(dir reflect.ChanDir) (reflect.Type, error) {
+	elem, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	// The reflect.ChanOf function panics for elements
+	// the runtime does not allow in channels.
+	if elem.Size() >= maxChanElemSize {
+		return nil, p.limitf("channel element %s is too large", elem)
+	}
+
+	return reflect.ChanOf(dir, elem), nil
+}
+
+// parseName parses the type name at the current position.
+func (p *typeParser) parseName() (reflect.Type, error) {
+	for _, name := range []string{"interface {}", "interface{}", "struct {}", "struct{}"} {
+		if p.consume(name) {
+			return predeclared[name], nil
+		}
+	}
+
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c != '_' && c != '.' && c != '/' && c != '-' &&
+			!(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') &&
+			!(c >= '0' && c <= '9') {
+			break
+		}
+
+		p.pos++
+	}
+
+	name := p.src[start:p.pos]
+	if name == "" {
+		return nil, p.errorf("missing type name")
+	}
+
+	t, ok := predeclared[name]
+	if !ok {
+		t, ok = registeredType(name)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown type %s", ErrInvalidTypeName, name)
+	}
+
+	return t, nil
+}
+
+// consume advances the position past the prefix if
+// the source continues with it.
+func (p *typeParser) consume(prefix string) bool {
+	if strings.HasPrefix(p.src[p.pos:], prefix) {
+		p.pos += len(prefix)
+		return true
+	}
+
+	return false
+}
+
+// skipSpace advances the position past spaces.
+func (p *typeParser) skipSpace() {
+	for p.pos < len(p.src) && p.src[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// errorf returns the parse error at the current position.
+func (p *typeParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s at position %d in %q", ErrInvalidTypeName,
+		fmt.Sprintf(format, args...), p.pos, p.src)
+}
+
+// limitf returns the error of the type at the current
+// position that exceeds the limits, see errTypeLimit.
+func (p *typeParser) limitf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %w", errTypeLimit, p.errorf(format, args...))
+}
@@ -0,0 +1,332 @@
+package kind
+
+import (
+	"encoding"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrValidation is returned when a value does not fit the expected Kind.
+var ErrValidation = errors.New("kind: value does not fit kind")
+
+// Validate checks that the value conforms to the type represented by the
+// expected Kind instance. Values of the exact type always conform, while
+// dynamic data, e.g. decoded by encoding/json into interface{}, is checked
+// structurally:
+//
+//   - a number fits a numeric kind if it is within the range of the kind,
+//     and fits an integer kind only if it has no fractional part;
+//   - a map with string keys or a struct fits a struct kind if every field
+//     that is not a pointer, slice, map or interface and has no omitempty
+//     option in its json tag is present; the field is looked up by its
+//     json name first, then by its Go name, and the fields of embedded
+//     structs are promoted as by encoding/json;
+//   - a slice or array fits a slice kind if every element fits,
+//     and an array kind if, in addition, its length matches;
+//   - a string fits a kind implementing encoding.TextUnmarshaler through
+//     a pointer, such as time.Time, if the kind accepts its text, and
+//     a byte slice kind if it is base64 encoded;
+//   - a map fits a map kind if every key and element fits.
+//
+// All mismatches are reported, joined into one error; each of them wraps
+// ErrValidation and names the path of the mismatched node.
+//
+// Example usage:
+//
+//	type User struct {
+//		Name string `json:"name"`
+//		Age  uint8  `json:"age"`
+//	}
+//
+//	var v interface{}
+//	json.Unmarshal([]byte(`{"name": "Bob", "age": 300}`), &v)
+//	err := kind.Validate(v, kind.For[User]())
+//	fmt.Println(err) // "kind: value does not fit kind: age: 300 overflows uint8"
+func Validate(v interface{}, expected *Kind) error {
+	if expected == nil || expected.rtype == nil {
+		if v != nil {
+			return fmt.Errorf("%w: expected nil, got %T", ErrValidation, v)
+		}
+
+		return nil
+	}
+
+	var errs []error
+	validate(reflect.ValueOf(v), expected.rtype, "", &errs)
+	return errors.Join(errs...)
+}
+
+// validate appends to errs the mismatches between the value and the type.
+func validate(v reflect.Value, t reflect.Type, path string, errs *[]error) {
+	fail := func(format string, args ...interface{}) {
+		msg := fmt.Sprintf(format, args...)
+		if path != "" {
+			msg = path + ": " + msg
+		}
+
+		*errs = append(*errs, fmt.Errorf("%w: %s", ErrValidation, msg))
+	}
+
+	// Values held by interfaces are checked by their dynamic type.
+	for v.IsValid() && v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+
+	if t.Kind() == reflect.Interface {
+		if v.IsValid() && !v.Type().Implements(t) {
+			fail("%s does not implement %s", v.Type(), t)
+		}
+
+		return
+	}
+
+	if !v.IsValid() {
+		if !nullable(t) {
+			fail("expected %s, got nil", t)
+		}
+
+		return
+	}
+
+	if v.Type().AssignableTo(t) {
+		return
+	}
+
+	// Pointers on both sides are optional.
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			if !nullable(t) {
+				fail("expected %s, got nil", t)
+			}
+
+			return
+		}
+
+		v = v.Elem()
+	}
+
+	if t.Kind() == reflect.Ptr {
+		validate(v, t.Elem(), path, errs)
+		return
+	}
+
+	// Like encoding/json, a string is decoded by the types implementing
+	// encoding.TextUnmarshaler, such as time.Time, and as base64 into
+	// a byte slice.
+	if v.Kind() == reflect.String {
+		switch {
+		case reflect.PtrTo(t).Implements(textUnmarshalerType):
+			u := reflect.New(t).Interface().(encoding.TextUnmarshaler)
+			if err := u.UnmarshalText([]byte(v.String())); err != nil {
+				fail("%v", err)
+			}
+
+			return
+		case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+			if _, err := base64.StdEncoding.DecodeString(v.String()); err != nil {
+				fail("invalid base64: %v", err)
+			}
+
+			return
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool, reflect.String:
+		if v.Kind() != t.Kind() {
+			fail("expected %s, got %s", t, v.Type())
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16,
+		reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		if msg := fitsNumber(v, t); msg != "" {
+			fail("%s", msg)
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			fail("expected %s, got %s", t, v.Type())
+			return
+		}
+
+		if t.Kind() == reflect.Array && v.Len() != t.Len() {
+			fail("expected %d elements, got %d", t.Len(), v.Len())
+			return
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			validate(v.Index(i), t.Elem(), path+"["+strconv.Itoa(i)+"]", errs)
+		}
+	case reflect.Map:
+		if v.Kind() != reflect.Map {
+			fail("expected %s, got %s", t, v.Type())
+			return
+		}
+
+		iter := v.MapRange()
+		for iter.Next() {
+			key := fmt.Sprint(iter.Key().Interface())
+			validate(iter.Key(), t.Key(), path+"["+key+"]", errs)
+			validate(iter.Value(), t.Elem(), path+"["+key+"]", errs)
+		}
+	case reflect.Struct:
+		validateStruct(v, t, path, fail, errs)
+	default:
+		fail("expected %s, got %s", t, v.Type())
+	}
+}
+
+// validateStruct checks the fields of the map or struct value
+// against the fields of the struct type.
+func validateStruct(
+	v reflect.Value,
+	t reflect.Type,
+	path string,
+	fail func(string, ...interface{}),
+	errs *[]error,
+) {
+	var lookup func(f jsonField) (reflect.Value, bool)
+	switch {
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String:
+		lookup = func(f jsonField) (reflect.Value, bool) {
+			for _, name := range []string{f.name, f.field.Name} {
+				key := reflect.ValueOf(name).Convert(v.Type().Key())
+				if fv := v.MapIndex(key); fv.IsValid() {
+					return fv, true
+				}
+			}
+
+			return reflect.Value{}, false
+		}
+	case v.Kind() == reflect.Struct:
+		vfields := jsonFields(v.Type())
+		lookup = func(f jsonField) (reflect.Value, bool) {
+			vf, ok := lookupJSONField(vfields, f)
+			if !ok {
+				return reflect.Value{}, false
+			}
+
+			// The field is missing if it is promoted through a nil pointer.
+			fv, err := v.FieldByIndexErr(vf.field.Index)
+			return fv, err == nil
+		}
+	default:
+		fail("expected %s, got %s", t, v.Type())
+		return
+	}
+
+	for _, f := range jsonFields(t) {
+		fpath := f.name
+		if path != "" {
+			fpath = path + "." + f.name
+		}
+
+		fv, ok := lookup(f)
+		if !ok {
+			if !nullable(f.field.Type) && !strings.Contains(f.opts, "omitempty") {
+				*errs = append(*errs, fmt.Errorf(
+					"%w: %s: missing field", ErrValidation, fpath))
+			}
+
+			continue
+		}
+
+		validate(fv, f.field.Type, fpath, errs)
+	}
+}
+
+// nullable returns true if nil is a valid value of the type.
+func nullable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface,
+		reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return true
+	}
+
+	return false
+}
+
+// fitsNumber returns the reason why the value does not fit
+// the numeric type, or an empty string if it fits.
+func fitsNumber(v reflect.Value, t reflect.Type) string {
+	var f float64
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		n := v.Int()
+		switch {
+		case isFloatKind(t):
+			return ""
+		case isUintKind(t) && n < 0:
+			return fmt.Sprintf("%d overflows %s", n, t)
+		case isUintKind(t):
+			if reflect.Zero(t).OverflowUint(uint64(n)) {
+				return fmt.Sprintf("%d overflows %s", n, t)
+			}
+		case reflect.Zero(t).OverflowInt(n):
+			return fmt.Sprintf("%d overflows %s", n, t)
+		}
+
+		return ""
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		n := v.Uint()
+		switch {
+		case isFloatKind(t):
+			return ""
+		case isUintKind(t):
+			if reflect.Zero(t).OverflowUint(n) {
+				return fmt.Sprintf("%d overflows %s", n, t)
+			}
+		case n > math.MaxInt64 || reflect.Zero(t).OverflowInt(int64(n)):
+			return fmt.Sprintf("%d overflows %s", n, t)
+		}
+
+		return ""
+	case reflect.Float32, reflect.Float64:
+		f = v.Float()
+	default:
+		return fmt.Sprintf("expected %s, got %s", t, v.Type())
+	}
+
+	switch {
+	case isFloatKind(t):
+		if reflect.Zero(t).OverflowFloat(f) {
+			return fmt.Sprintf("%v overflows %s", f, t)
+		}
+	case f != math.Trunc(f):
+		return fmt.Sprintf("%v is not an integer", f)
+	case isUintKind(t):
+		if f < 0 || f >= math.MaxUint64 || reflect.Zero(t).OverflowUint(uint64(f)) {
+			return fmt.Sprintf("%v overflows %s", f, t)
+		}
+	default:
+		if f < math.MinInt64 || f >= math.MaxInt64 ||
+			reflect.Zero(t).OverflowInt(int64(f)) {
+			return fmt.Sprintf("%v overflows %s", f, t)
+		}
+	}
+
+	return ""
+}
+
+// isFloatKind returns true if the type is a floating-point type.
+func isFloatKind(t reflect.Type) bool {
+	return t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+}
+
+// isUintKind returns true if the type is an unsigned integer type.
+func isUintKind(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,248 @@
+package kind
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestValidate tests the Validate function.
+func TestValidate(t *testing.T) {
+	type Address struct {
+		City string `json:"city"`
+		Zip  string `json:"zip,omitempty"`
+	}
+
+	type User struct {
+		Name    string            `json:"name"`
+		Age     uint8             `json:"age"`
+		Score   float32           `json:"score,omitempty"`
+		Tags    []string          `json:"tags"`
+		Address *Address          `json:"address"`
+		Point   [2]int            `json:"point,omitempty"`
+		Meta    map[string]int    `json:"meta"`
+		Any     interface{}       `json:"any"`
+		Skip    func()            `json:"-"`
+		Extra   map[string]string `json:"extra"`
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected *Kind
+		errs     []string // substrings of the expected errors
+	}{
+		{
+			name:     "valid",
+			input:    `{"name": "Bob", "age": 30, "tags": ["a"], "address": {"city": "Kyiv"}}`,
+			expected: For[User](),
+		},
+		{
+			name:     "missing fields",
+			input:    `{"address": {}}`,
+			expected: For[User](),
+			errs:     []string{"name: missing field", "age: missing field", "address.city: missing field"},
+		},
+		{
+			name:     "wrong kinds",
+			input:    `{"name": 1, "age": "30", "tags": [1]}`,
+			expected: For[User](),
+			errs:     []string{"name: expected string, got float64", "age: expected uint8, got string", "tags[0]: expected string, got float64"},
+		},
+		{
+			name:     "numeric ranges",
+			input:    `{"name": "Bob", "age": 300, "score": 1e100, "point": [1.5, 2], "meta": {"a": -1}}`,
+			expected: For[User](),
+			errs:     []string{"age: 300 overflows uint8", "score: 1e+100 overflows float32", "point[0]: 1.5 is not an integer"},
+		},
+		{
+			name:     "array length",
+			input:    `{"name": "Bob", "age": 1, "point": [1]}`,
+			expected: For[User](),
+			errs:     []string{"point: expected 2 elements, got 1"},
+		},
+		{
+			name:     "root",
+			input:    `[1, 2]`,
+			expected: For[map[string]int](),
+			errs:     []string{"kind: value does not fit kind: expected map[string]int, got []interface {}"},
+		},
+		{
+			name:     "nil",
+			input:    `null`,
+			expected: For[int](),
+			errs:     []string{"expected int, got nil"},
+		},
+		{
+			name:     "nil pointer",
+			input:    `null`,
+			expected: For[*int](),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v interface{}
+			if err := json.Unmarshal([]byte(tt.input), &v); err != nil {
+				t.Fatal(err)
+			}
+
+			err := Validate(v, tt.expected)
+			if len(tt.errs) == 0 {
+				if err != nil {
+					t.Errorf("Expected no error, but got %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, ErrValidation) {
+				t.Fatalf("Expected ErrValidation, but got %v", err)
+			}
+
+			lines := strings.Split(err.Error(), "\n")
+			if len(lines) != len(tt.errs) {
+				t.Errorf("Expected %d errors, but got %d: %v",
+					len(tt.errs), len(lines), err)
+			}
+
+			for _, want := range tt.errs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Expected error %q in %v", want, err)
+				}
+			}
+		})
+	}
+}
+
+// TestValidateTyped tests the Validate function with typed values.
+func TestValidateTyped(t *testing.T) {
+	type Point struct{ X, Y int8 }
+	type Other struct {
+		X int64
+		Y uint
+	}
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected *Kind
+		valid    bool
+	}{
+		{"same type", Point{1, 2}, For[Point](), true},
+		{"pointer value", &Point{1, 2}, For[Point](), true},
+		{"other struct", Other{1, 2}, For[Point](), true},
+		{"other struct overflow", Other{1000, 2}, For[Point](), false},
+		{"int to float", 10, For[float64](), true},
+		{"negative to uint", -1, For[uint](), false},
+		{"interface", 10, For[error](), false},
+		{"nil kind", nil, Of(nil), true},
+		{"nil kind value", 1, Of(nil), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.input, tt.expected)
+			if (err == nil) != tt.valid {
+				t.Errorf("Expected valid %v, but got %v", tt.valid, err)
+			}
+		})
+	}
+}
+
+// TestValidateEmbedded tests the Validate function
+// for structs with embedded structs.
+func TestValidateEmbedded(t *testing.T) {
+	type Base struct {
+		ID int `json:"id"`
+	}
+
+	type User struct {
+		Base
+		Name string `json:"name"`
+	}
+
+	type Audit struct {
+		*Base
+		By string `json:"by"`
+	}
+
+	data, err := json.Marshal(User{Base{ID: 1}, "bob"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Validate(v, For[User]()); err != nil {
+		t.Errorf("Expected %s to be valid, but got %v", data, err)
+	}
+
+	if err := Validate(map[string]interface{}{"name": "bob"}, For[User]()); err == nil ||
+		!strings.Contains(err.Error(), "id: missing field") {
+		t.Errorf("Expected the promoted field to be missing, but got %v", err)
+	}
+
+	if err := Validate(User{Base{ID: 1}, "bob"}, For[Audit]()); err == nil ||
+		!strings.Contains(err.Error(), "by: missing field") {
+		t.Errorf("Expected the by field to be missing, but got %v", err)
+	}
+
+	if err := Validate(Audit{By: "bob"}, For[User]()); err == nil ||
+		!strings.Contains(err.Error(), "id: missing field") {
+		t.Errorf("Expected the field behind nil to be missing, but got %v", err)
+	}
+}
+
+// TestValidateText tests the Validate function for the kinds
+// encoded by encoding/json as strings.
+func TestValidateText(t *testing.T) {
+	type Event struct {
+		At      time.Time  `json:"at"`
+		Until   *time.Time `json:"until"`
+		Payload []byte     `json:"payload"`
+		Addr    net.IP     `json:"addr"`
+	}
+
+	data, err := json.Marshal(Event{
+		At:      time.Now(),
+		Payload: []byte{0, 1, 2},
+		Addr:    net.IPv4(127, 0, 0, 1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Validate(v, For[Event]()); err != nil {
+		t.Errorf("Expected %s to be valid, but got %v", data, err)
+	}
+
+	invalid := map[string]interface{}{
+		"at":      "yesterday",
+		"until":   "2024-01-01T00:00:00Z",
+		"payload": "%%%",
+		"addr":    "localhost",
+	}
+
+	err = Validate(invalid, For[Event]())
+	for _, want := range []string{"at: ", "payload: invalid base64", "addr: "} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error %q in %v", want, err)
+		}
+	}
+
+	if err != nil && strings.Contains(err.Error(), "until") {
+		t.Errorf("Expected the until field to be valid, but got %v", err)
+	}
+}
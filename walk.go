@@ -0,0 +1,265 @@
+package kind
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// SkipNode is used as a return value from WalkFunc to indicate that
+// the children of the node are to be skipped. It is not returned as
+// an error by Walk.
+var SkipNode = errors.New("kind: skip this node")
+
+// WalkFunc is the type of the function called by Walk to visit each node
+// of a value. The path is empty for the root node, see Walk for the
+// format. The Kind instance holds the value of the node.
+//
+// If the function returns SkipNode, Walk does not visit the children
+// of the node. Any other non-nil error stops the walk and is returned
+// by Walk.
+type WalkFunc func(path string, k *Kind) error
+
+// Walk walks the value depth-first, calling fn for the value itself and
+// for every node nested in it: the exported fields of structs, which are
+// appended to the path as ".Name", the elements of slices and arrays,
+// appended as "[i]", and the elements of maps, appended as "[key]" and
+// visited in the order of the formatted keys. Pointers and interfaces
+// are followed without extra nodes, so interface-typed nodes get the
+// Kinds of their dynamic values, see ResolveDynamic. A pointer that
+// refers back to a node on the current path is handled according to
+// WithCycleMode, by default it is visited but not followed again.
+//
+// The walk can be bounded by the WithMaxDepth and WithMaxNodes options,
+// where the depth of a node is the number of its ancestors. If the limits
+// stop the walk, the visited nodes stay visited and Walk returns
+// ErrTruncated.
+//
+// Example usage:
+//
+//	type User struct {
+//		Name string
+//		Tags []string
+//	}
+//
+//	kind.Walk(User{"Bob", []string{"admin"}}, func(path string, k *kind.Kind) error {
+//		fmt.Printf("%q %s\n", path, k.Name())
+//		return nil
+//	})
+//	// Output:
+//	// "" main.User
+//	// "Name" string
+//	// "Tags" []string
+//	// "Tags[0]" string
+func Walk(v interface{}, fn WalkFunc, opts ...DeepOption) error {
+	w := &walker{
+		fn:     fn,
+		config: newDeepConfig(0, opts),
+		seen:   map[uintptr]string{},
+	}
+
+	err := w.walk(reflect.ValueOf(v), "", 0)
+	if errors.Is(err, SkipNode) {
+		return nil
+	}
+
+	if err == nil && w.truncated {
+		return ErrTruncated
+	}
+
+	return err
+}
+
+// RangeMap calls fn for each entry of the map held by the Kind instance,
+// in the order of the formatted keys, until fn returns false. The Kind
+// instances of the entries hold the key and the value; their types are
+// the dynamic types if the static types are interfaces, so a nil
+// interface value gets the nil Kind. RangeMap does nothing if the Kind
+// instance does not hold a map.
+//
+// Example usage:
+//
+//	m := map[string]interface{}{"id": 1, "name": "Bob"}
+//	kind.Of(m).RangeMap(func(key, val *kind.Kind) bool {
+//		s, _ := key.AsString()
+//		fmt.Println(s, val.Name()) // "id int", "name string"
+//		return true
+//	})
+func (k *Kind) RangeMap(fn func(key, val *Kind) bool) {
+	if k.rtype == nil || k.rtype.Kind() != reflect.Map || k.value == nil {
+		return
+	}
+
+	m := reflect.ValueOf(k.value)
+	for _, key := range sortedKeys(m) {
+		if !fn(valueKind(key), valueKind(m.MapIndex(key))) {
+			return
+		}
+	}
+}
+
+// The walker holds the state of Walk.
+type walker struct {
+	fn        WalkFunc
+	config    deepConfig
+	seen      map[uintptr]string // paths of pointers on the current path
+	nodes     int                // number of visited nodes
+	truncated bool               // limits stopped the walk
+}
+
+// walk calls fn for the value and its children.
+func (w *walker) walk(v reflect.Value, path string, depth int) error {
+	if w.config.maxNodes > 0 && w.nodes >= w.config.maxNodes {
+		w.truncated = true
+		return nil
+	}
+
+	k := valueKind(v)
+	opaque := w.config.static && v.Kind() == reflect.Interface
+	if opaque {
+		k = &Kind{typeNode: cachedNode(v.Type()), value: v.Interface()}
+	}
+
+	if ref, ok := w.backRef(v); ok && !opaque {
+		switch w.config.cycles {
+		case CycleError:
+			return fmt.Errorf("%w: %s refers back to %q",
+				ErrCyclicValue, path, ref)
+		case CycleMark:
+			k = Of(CycleRef{Path: ref})
+			v = reflect.Value{}
+		}
+	}
+
+	w.nodes++
+	if err := w.fn(path, k); err != nil {
+		if errors.Is(err, SkipNode) {
+			return nil
+		}
+
+		return err
+	}
+
+	if opaque {
+		return nil
+	}
+
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+
+		if v.Kind() == reflect.Ptr {
+			if _, ok := w.seen[v.Pointer()]; ok {
+				return nil
+			}
+
+			w.seen[v.Pointer()] = path
+			defer delete(w.seen, v.Pointer())
+		}
+
+		v = v.Elem()
+	}
+
+	if w.config.maxDepth > 0 && depth >= w.config.maxDepth {
+		switch v.Kind() {
+		case reflect.Struct:
+			w.truncated = w.truncated || v.NumField() > 0
+		case reflect.Slice, reflect.Array, reflect.Map:
+			w.truncated = w.truncated || v.Len() > 0
+		}
+
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+
+			err := w.walk(v.Field(i), fieldPath(path, t.Field(i).Name), depth+1)
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := w.walk(v.Index(i), indexPath(path, i), depth+1); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, key := range sortedKeys(v) {
+			err := w.walk(v.MapIndex(key), keyPath(path, key), depth+1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// backRef returns the path of the node on the current path that the
+// value refers back to through pointers and interfaces, if any.
+func (w *walker) backRef(v reflect.Value) (string, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", false
+		}
+
+		if v.Kind() == reflect.Ptr {
+			if ref, ok := w.seen[v.Pointer()]; ok {
+				return ref, true
+			}
+		}
+
+		v = v.Elem()
+	}
+
+	return "", false
+}
+
+// valueKind returns the Kind instance of the reflect value.
+func valueKind(v reflect.Value) *Kind {
+	if !v.IsValid() {
+		return Of(nil)
+	}
+
+	return Of(v.Interface())
+}
+
+// fieldPath returns the path of the struct field.
+func fieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+// indexPath returns the path of the sequence element.
+func indexPath(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// keyPath returns the path of the map element.
+func keyPath(path string, key reflect.Value) string {
+	return path + "[" + fmt.Sprint(key.Interface()) + "]"
+}
+
+// sortedKeys returns the keys of the map sorted by their formatted form,
+// so maps are walked in a stable order.
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+
+	return keys
+}
@@ -0,0 +1,282 @@
+package kind
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// TestWalk tests the Walk function.
+func TestWalk(t *testing.T) {
+	type Node struct {
+		Name  string
+		Next  *Node
+		Meta  map[string]interface{}
+		Pair  [2]int
+		inner int
+	}
+
+	loop := &Node{Name: "loop"}
+	loop.Next = loop
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected []string // "path kind" pairs
+	}{
+		{
+			name:     "scalar",
+			input:    10,
+			expected: []string{" int"},
+		},
+		{
+			name:     "nil",
+			input:    nil,
+			expected: []string{" nil"},
+		},
+		{
+			name: "nested",
+			input: Node{
+				Name: "a",
+				Next: &Node{Name: "b"},
+				Meta: map[string]interface{}{"y": []int{1}, "x": "s"},
+			},
+			expected: []string{
+				" kind.Node",
+				"Name string",
+				"Next *kind.Node",
+				"Next.Name string",
+				"Next.Next *kind.Node",
+				"Next.Meta map[string]interface {}",
+				"Next.Pair [2]int",
+				"Next.Pair[0] int",
+				"Next.Pair[1] int",
+				"Meta map[string]interface {}",
+				"Meta[x] string",
+				"Meta[y] []int",
+				"Meta[y][0] int",
+				"Pair [2]int",
+				"Pair[0] int",
+				"Pair[1] int",
+			},
+		},
+		{
+			name:  "cycle",
+			input: loop,
+			expected: []string{
+				" *kind.Node",
+				"Name string",
+				"Next *kind.Node",
+				"Meta map[string]interface {}",
+				"Pair [2]int",
+				"Pair[0] int",
+				"Pair[1] int",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			err := Walk(tt.input, func(path string, k *Kind) error {
+				got = append(got, path+" "+k.Name())
+				return nil
+			})
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %q, but got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestWalkStop tests the SkipNode and error results of the WalkFunc.
+func TestWalkStop(t *testing.T) {
+	value := map[string][]int{"a": {1, 2}, "b": {3}}
+
+	var got []string
+	err := Walk(value, func(path string, k *Kind) error {
+		got = append(got, path)
+		if path == "[a]" {
+			return SkipNode
+		}
+
+		return nil
+	})
+
+	expected := []string{"", "[a]", "[b]", "[b][0]"}
+	if err != nil || !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %q, but got %q, %v", expected, got, err)
+	}
+
+	errStop := errors.New("stop")
+	err = Walk(value, func(path string, k *Kind) error {
+		if path == "[b]" {
+			return errStop
+		}
+
+		return nil
+	})
+
+	if err != errStop {
+		t.Errorf("Expected %v, but got %v", errStop, err)
+	}
+}
+
+// TestRangeMap tests the RangeMap method.
+func TestRangeMap(t *testing.T) {
+	m := map[interface{}]interface{}{"b": 1, "a": "x", 1: nil}
+
+	var got []string
+	Of(m).RangeMap(func(key, val *Kind) bool {
+		got = append(got, fmt.Sprintf("%v:%s=%s", key.value, key, val))
+		return true
+	})
+
+	expected := []string{"1:int=nil", "a:string=string", "b:string=int"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %q, but got %q", expected, got)
+	}
+
+	n := 0
+	Of(map[string]int{"a": 1, "b": 2}).RangeMap(func(key, val *Kind) bool {
+		n++
+		return false
+	})
+
+	if n != 1 {
+		t.Errorf("Expected the iteration to stop, but got %d calls", n)
+	}
+
+	for _, v := range []interface{}{nil, 1, []int{1}, map[string]int(nil)} {
+		Of(v).RangeMap(func(key, val *Kind) bool {
+			t.Errorf("Unexpected entry for %T", v)
+			return true
+		})
+	}
+}
+
+// TestWalkCycles tests the handling of pointer cycles in values.
+func TestWalkCycles(t *testing.T) {
+	type Node struct {
+		Name string
+		Next *Node
+		Any  interface{}
+	}
+
+	a := &Node{Name: "a"}
+	b := &Node{Name: "b", Next: a}
+	a.Next = b
+	a.Any = a
+
+	var got []string
+	collect := func(path string, k *Kind) error {
+		if ref, ok := k.AsCycleRef(); ok {
+			path += " -> " + ref.Path
+		}
+
+		got = append(got, path)
+		return nil
+	}
+
+	tests := []struct {
+		name     string
+		mode     CycleMode
+		expected []string
+		err      error
+	}{
+		{
+			name: "skip",
+			mode: CycleSkip,
+			expected: []string{"", "Name", "Next", "Next.Name",
+				"Next.Next", "Next.Any", "Any"},
+		},
+		{
+			name: "mark",
+			mode: CycleMark,
+			expected: []string{"", "Name", "Next", "Next.Name",
+				"Next.Next -> ", "Next.Any", "Any -> "},
+		},
+		{
+			name:     "error",
+			mode:     CycleError,
+			expected: []string{"", "Name", "Next", "Next.Name"},
+			err:      ErrCyclicValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = nil
+			err := Walk(a, collect, WithCycleMode(tt.mode))
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Expected error %v, but got %v", tt.err, err)
+			}
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %q, but got %q", tt.expected, got)
+			}
+		})
+	}
+
+	if _, ok := Of(1).AsCycleRef(); ok {
+		t.Error("Expected no cycle marker for int")
+	}
+}
+
+// TestWalkResolveDynamic tests the ResolveDynamic option.
+func TestWalkResolveDynamic(t *testing.T) {
+	type Entry struct {
+		Message string
+		Fields  map[string]interface{}
+		Err     error
+	}
+
+	value := Entry{
+		Message: "done",
+		Fields:  map[string]interface{}{"n": 1, "user": &Entry{}, "x": nil},
+	}
+
+	tests := []struct {
+		name     string
+		resolve  bool
+		expected []string
+	}{
+		{
+			name:    "dynamic",
+			resolve: true,
+			expected: []string{" kind.Entry", "Message string",
+				"Fields map[string]interface {}", "Fields[n] int",
+				"Fields[user] *kind.Entry", "Fields[user].Message string",
+				"Fields[user].Fields map[string]interface {}",
+				"Fields[user].Err nil", "Fields[x] nil", "Err nil"},
+		},
+		{
+			name:    "static",
+			resolve: false,
+			expected: []string{" kind.Entry", "Message string",
+				"Fields map[string]interface {}",
+				"Fields[n] interface {}", "Fields[user] interface {}",
+				"Fields[x] interface {}", "Err error"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			Walk(value, func(path string, k *Kind) error {
+				got = append(got, path+" "+k.Name())
+				return nil
+			}, ResolveDynamic(tt.resolve))
+
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %q, but got %q", tt.expected, got)
+			}
+		})
+	}
+}